@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// The admission policy types are newer than the k8s.io/api version in use, so the subset of the
+// admissionregistration.k8s.io types read by the provider is declared here. The fields are identical
+// in the v1alpha1, v1beta1 and v1 versions of the API.
+
+type ValidatingAdmissionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ValidatingAdmissionPolicy `json:"items"`
+}
+
+type ValidatingAdmissionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ValidatingAdmissionPolicySpec   `json:"spec,omitempty"`
+	Status            ValidatingAdmissionPolicyStatus `json:"status,omitempty"`
+}
+
+type ValidatingAdmissionPolicySpec struct {
+	ParamKind        *ParamKind        `json:"paramKind,omitempty"`
+	MatchConstraints *MatchResources   `json:"matchConstraints,omitempty"`
+	Validations      []Validation      `json:"validations,omitempty"`
+	FailurePolicy    *string           `json:"failurePolicy,omitempty"`
+	AuditAnnotations []AuditAnnotation `json:"auditAnnotations,omitempty"`
+	MatchConditions  []MatchCondition  `json:"matchConditions,omitempty"`
+	Variables        []PolicyVariable  `json:"variables,omitempty"`
+}
+
+type ValidatingAdmissionPolicyStatus struct {
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	TypeChecking       *TypeChecking      `json:"typeChecking,omitempty"`
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type ParamKind struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+}
+
+type MatchResources struct {
+	NamespaceSelector    *metav1.LabelSelector     `json:"namespaceSelector,omitempty"`
+	ObjectSelector       *metav1.LabelSelector     `json:"objectSelector,omitempty"`
+	ResourceRules        []NamedRuleWithOperations `json:"resourceRules,omitempty"`
+	ExcludeResourceRules []NamedRuleWithOperations `json:"excludeResourceRules,omitempty"`
+	MatchPolicy          *string                   `json:"matchPolicy,omitempty"`
+}
+
+type NamedRuleWithOperations struct {
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	admissionregistrationv1.RuleWithOperations
+}
+
+type Validation struct {
+	Expression        string  `json:"expression"`
+	Message           string  `json:"message,omitempty"`
+	Reason            *string `json:"reason,omitempty"`
+	MessageExpression string  `json:"messageExpression,omitempty"`
+}
+
+type AuditAnnotation struct {
+	Key             string `json:"key"`
+	ValueExpression string `json:"valueExpression"`
+}
+
+type MatchCondition struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+type PolicyVariable struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+type TypeChecking struct {
+	ExpressionWarnings []ExpressionWarning `json:"expressionWarnings,omitempty"`
+}
+
+type ExpressionWarning struct {
+	FieldRef string `json:"fieldRef"`
+	Warning  string `json:"warning"`
+}
+
+type ValidatingAdmissionPolicyBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ValidatingAdmissionPolicyBinding `json:"items"`
+}
+
+type ValidatingAdmissionPolicyBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ValidatingAdmissionPolicyBindingSpec `json:"spec,omitempty"`
+}
+
+type ValidatingAdmissionPolicyBindingSpec struct {
+	PolicyName        string          `json:"policyName,omitempty"`
+	ParamRef          *ParamRef       `json:"paramRef,omitempty"`
+	MatchResources    *MatchResources `json:"matchResources,omitempty"`
+	ValidationActions []string        `json:"validationActions,omitempty"`
+}
+
+type ParamRef struct {
+	Name                    string                `json:"name,omitempty"`
+	Namespace               string                `json:"namespace,omitempty"`
+	Selector                *metav1.LabelSelector `json:"selector,omitempty"`
+	ParameterNotFoundAction *string               `json:"parameterNotFoundAction,omitempty"`
+}
+
+// admissionPolicyVersions are the versions the admission policy APIs are served at, newest first.
+var admissionPolicyVersions = []string{"v1", "v1beta1", "v1alpha1"}
+
+// AdmissionPolicyPaths returns the API paths of the resource in every version it may be served at.
+func AdmissionPolicyPaths(resource string) []string {
+	paths := make([]string, len(admissionPolicyVersions))
+	for i, v := range admissionPolicyVersions {
+		paths[i] = admissionPolicyPath(v, resource)
+	}
+	return paths
+}
+
+// admissionPolicyAPI lists the admission policy resources from the newest version of the API served by the
+// cluster, which is resolved once per resource and shared by the admission policy clients.
+type admissionPolicyAPI struct {
+	rest     rest.Interface
+	mu       sync.Mutex
+	versions map[string]string
+}
+
+func newAdmissionPolicyAPI(rest rest.Interface) *admissionPolicyAPI {
+	return &admissionPolicyAPI{rest: rest, versions: make(map[string]string)}
+}
+
+func (a *admissionPolicyAPI) list(ctx context.Context, resource string, opts metav1.ListOptions, into interface{}) error {
+	path, err := a.path(ctx, resource)
+	if err != nil {
+		return err
+	}
+	return listPath(ctx, a.rest, path, opts, into)
+}
+
+// path returns the API path of the resource in the newest version served by the cluster.
+func (a *admissionPolicyAPI) path(ctx context.Context, resource string) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if v, ok := a.versions[resource]; ok {
+		return admissionPolicyPath(v, resource), nil
+	}
+	var err error
+	for _, v := range admissionPolicyVersions {
+		// listing a single object is enough to tell whether the version is served
+		err = listPath(ctx, a.rest, admissionPolicyPath(v, resource), metav1.ListOptions{Limit: 1}, &metav1.PartialObjectMetadataList{})
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		a.versions[resource] = v
+		return admissionPolicyPath(v, resource), nil
+	}
+	return "", err
+}
+
+func admissionPolicyPath(version, resource string) string {
+	return "/apis/" + admissionregistrationv1.GroupName + "/" + version + "/" + resource
+}
+
+type validatingAdmissionPoliciesClient struct {
+	api *admissionPolicyAPI
+}
+
+func (c validatingAdmissionPoliciesClient) List(ctx context.Context, opts metav1.ListOptions) (*ValidatingAdmissionPolicyList, error) {
+	var list ValidatingAdmissionPolicyList
+	if err := c.api.list(ctx, "validatingadmissionpolicies", opts, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+type validatingAdmissionPolicyBindingsClient struct {
+	api *admissionPolicyAPI
+}
+
+func (c validatingAdmissionPolicyBindingsClient) List(ctx context.Context, opts metav1.ListOptions) (*ValidatingAdmissionPolicyBindingList, error) {
+	var list ValidatingAdmissionPolicyBindingList
+	if err := c.api.list(ctx, "validatingadmissionpolicybindings", opts, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest/fake"
+)
+
+func TestListAdmissionPolicyResourceFallback(t *testing.T) {
+	var requested []string
+	rest := &fake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			requested = append(requested, req.URL.Path)
+			header := http.Header{"Content-Type": []string{"application/json"}}
+			if req.URL.Path != "/apis/admissionregistration.k8s.io/v1beta1/validatingadmissionpolicies" {
+				body := `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`
+				return &http.Response{StatusCode: http.StatusNotFound, Header: header, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+			}
+			body := `{"items":[{"metadata":{"name":"replicas-limit","uid":"1"},"spec":{"failurePolicy":"Fail","validations":[{"expression":"object.spec.replicas <= 5"}]}}]}`
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+		}),
+	}
+
+	api := newAdmissionPolicyAPI(rest)
+	list, err := validatingAdmissionPoliciesClient{api: api}.List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requested) != 3 {
+		t.Fatalf("expected v1 and v1beta1 to be probed and v1beta1 to be listed, got %v", requested)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "replicas-limit" || list.Items[0].Spec.Validations[0].Expression != "object.spec.replicas <= 5" {
+		t.Fatalf("unexpected policies: %+v", list.Items)
+	}
+
+	// the served version is resolved once
+	requested = nil
+	if _, err := (validatingAdmissionPoliciesClient{api: api}).List(context.Background(), metav1.ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(requested) != 1 {
+		t.Fatalf("expected only v1beta1 to be listed, got %v", requested)
+	}
+
+	requested = nil
+	if _, err := (validatingAdmissionPolicyBindingsClient{api: api}).List(context.Background(), metav1.ListOptions{}); err == nil {
+		t.Fatal("expected an error when no version is served")
+	}
+	if len(requested) != len(admissionPolicyVersions) {
+		t.Fatalf("expected every version to be requested, got %v", requested)
+	}
+}
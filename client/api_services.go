@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// The apiregistration.k8s.io types live in k8s.io/kube-aggregator, which the provider doesn't depend on, so the
+// subset of the types read by the provider is declared here.
+
+const APIServicesPath = "/apis/apiregistration.k8s.io/v1/apiservices"
+
+type APIServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIService `json:"items"`
+}
+
+type APIService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              APIServiceSpec   `json:"spec,omitempty"`
+	Status            APIServiceStatus `json:"status,omitempty"`
+}
+
+type APIServiceSpec struct {
+	Service               *APIServiceReference `json:"service,omitempty"`
+	Group                 string               `json:"group,omitempty"`
+	Version               string               `json:"version,omitempty"`
+	InsecureSkipTLSVerify bool                 `json:"insecureSkipTLSVerify,omitempty"`
+	CABundle              []byte               `json:"caBundle,omitempty"`
+	GroupPriorityMinimum  int32                `json:"groupPriorityMinimum"`
+	VersionPriority       int32                `json:"versionPriority"`
+}
+
+type APIServiceReference struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Port      *int32 `json:"port,omitempty"`
+}
+
+type APIServiceStatus struct {
+	Conditions []APIServiceCondition `json:"conditions,omitempty"`
+}
+
+type APIServiceCondition struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+}
+
+type apiServicesClient struct {
+	rest rest.Interface
+}
+
+func (c apiServicesClient) List(ctx context.Context, opts metav1.ListOptions) (*APIServiceList, error) {
+	var list APIServiceList
+	if err := listPath(ctx, c.rest, APIServicesPath, opts, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// listPath lists the objects served at the API path into a list of a type the client-go scheme doesn't know about.
+func listPath(ctx context.Context, c rest.Interface, path string, opts metav1.ListOptions, into interface{}) error {
+	body, err := c.Get().AbsPath(path).SpecificallyVersionedParams(&opts, metav1.ParameterCodec, metav1.SchemeGroupVersion).Do(ctx).Raw()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, into)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest/fake"
+)
+
+func TestAPIServicesList(t *testing.T) {
+	rest := &fake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != APIServicesPath || req.URL.Query().Get("continue") != "token" {
+				t.Fatalf("unexpected request %s", req.URL)
+			}
+			body := `{"items":[{"metadata":{"name":"v1beta1.metrics.k8s.io","uid":"1"},"spec":{"service":{"namespace":"kube-system","name":"metrics-server","port":443},"group":"metrics.k8s.io","version":"v1beta1","insecureSkipTLSVerify":true,"groupPriorityMinimum":100,"versionPriority":100},"status":{"conditions":[{"type":"Available","status":"False","reason":"FailedDiscoveryCheck"}]}}]}`
+			header := http.Header{"Content-Type": []string{"application/json"}}
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+		}),
+	}
+
+	list, err := apiServicesClient{rest: rest}.List(context.Background(), metav1.ListOptions{Continue: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("expected one APIService, got %d", len(list.Items))
+	}
+	s := list.Items[0]
+	if s.Spec.Service == nil || s.Spec.Service.Name != "metrics-server" || !s.Spec.InsecureSkipTLSVerify || s.Status.Conditions[0].Reason != "FailedDiscoveryCheck" {
+		t.Fatalf("unexpected APIService: %+v", s)
+	}
+}
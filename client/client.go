@@ -2,7 +2,9 @@ package client
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -15,12 +17,14 @@ import (
 )
 
 type Client struct {
-	Log      hclog.Logger
-	services map[string]Services
-	kConfig  api.Config
-	config   *Config
-	contexts []string
-	paths    map[string]struct{}
+	Log         hclog.Logger
+	services    map[string]Services
+	kConfig     api.Config
+	config      *Config
+	contexts    []string
+	paths       map[string]struct{}
+	owners      *ownerIndexes
+	pssVersions *podSecurityVersions
 
 	Context string
 }
@@ -33,18 +37,104 @@ func (c *Client) Services() Services {
 	return c.services[c.Context]
 }
 
+// EventsMaxAge returns the maximum age of the events to fetch, 0 if all events are fetched.
+func (c *Client) EventsMaxAge() time.Duration {
+	if c.config == nil || c.config.EventsMaxAgeMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(c.config.EventsMaxAgeMinutes) * time.Minute
+}
+
+// EventsCutoff returns the time the fetched events must have been last seen at or after, zero if all events are
+// fetched.
+func (c *Client) EventsCutoff() time.Time {
+	maxAge := c.EventsMaxAge()
+	if maxAge == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-maxAge)
+}
+
+// EventsSeenSince returns the events last seen at or after cutoff, all events if cutoff is zero.
+func EventsSeenSince[E any](events []E, cutoff time.Time, lastSeen func(E) time.Time) []E {
+	if cutoff.IsZero() {
+		return events
+	}
+	filtered := make([]E, 0, len(events))
+	for _, e := range events {
+		if !lastSeen(e).Before(cutoff) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// EventLastSeen returns the latest of the times an event records, unset times are zero and ignored.
+func EventLastSeen(times ...time.Time) time.Time {
+	var last time.Time
+	for _, t := range times {
+		if t.After(last) {
+			last = t
+		}
+	}
+	return last
+}
+
+// Server returns the URL of the API server of the current context.
+func (c *Client) Server() string {
+	kCtx, ok := c.kConfig.Contexts[c.Context]
+	if !ok {
+		return ""
+	}
+	cluster, ok := c.kConfig.Clusters[kCtx.Cluster]
+	if !ok {
+		return ""
+	}
+	return cluster.Server
+}
+
+// AuthMethod returns how the current context authenticates to the API server, one of exec, auth-provider, token,
+// client-certificate, basic or none. For exec and auth-provider the name of the plugin is returned as well.
+func (c *Client) AuthMethod() (method string, plugin string) {
+	kCtx, ok := c.kConfig.Contexts[c.Context]
+	if !ok {
+		return "", ""
+	}
+	authInfo, ok := c.kConfig.AuthInfos[kCtx.AuthInfo]
+	if !ok {
+		return "none", ""
+	}
+	switch {
+	case authInfo.Exec != nil:
+		return "exec", filepath.Base(authInfo.Exec.Command)
+	case authInfo.AuthProvider != nil:
+		return "auth-provider", authInfo.AuthProvider.Name
+	case authInfo.Token != "" || authInfo.TokenFile != "":
+		return "token", ""
+	case len(authInfo.ClientCertificateData) > 0 || authInfo.ClientCertificate != "":
+		return "client-certificate", ""
+	case authInfo.Username != "":
+		return "basic", ""
+	}
+	return "none", ""
+}
+
 func (c Client) WithContext(context string) *Client {
 	return &Client{
-		Log:      c.Log.With("context", context),
-		services: c.services,
-		kConfig:  c.kConfig,
-		config:   c.config,
-		Context:  context,
+		Log:         c.Log.With("context", context),
+		services:    c.services,
+		kConfig:     c.kConfig,
+		config:      c.config,
+		owners:      c.owners,
+		pssVersions: c.pssVersions,
+		Context:     context,
 	}
 }
 
 func (c *Client) SetServices(s map[string]Services) {
 	c.services = s
+	c.owners = &ownerIndexes{}
+	c.pssVersions = &podSecurityVersions{}
 	contexts := make([]string, 0, len(s))
 	for k := range s {
 		contexts = append(contexts, k)
@@ -95,13 +185,15 @@ func Configure(logger hclog.Logger, config interface{}) (schema.ClientMeta, diag
 	}
 
 	c := Client{
-		Log:      logger,
-		services: make(map[string]Services),
-		kConfig:  kCfg,
-		config:   cfg,
-		contexts: contexts,
-		Context:  contexts[0],
-		paths:    make(map[string]struct{}),
+		Log:         logger,
+		services:    make(map[string]Services),
+		kConfig:     kCfg,
+		config:      cfg,
+		contexts:    contexts,
+		Context:     contexts[0],
+		paths:       make(map[string]struct{}),
+		owners:      &ownerIndexes{},
+		pssVersions: &podSecurityVersions{},
 	}
 
 	for _, ctxName := range contexts {
@@ -152,24 +244,48 @@ func getAPIsMap(client *kubernetes.Clientset) (map[string]struct{}, error) {
 }
 
 func initServices(client *kubernetes.Clientset) Services {
+	admissionPolicies := newAdmissionPolicyAPI(client.AdmissionregistrationV1().RESTClient())
 	return Services{
-		Client:          client,
-		CronJobs:        client.BatchV1().CronJobs(""),
-		DaemonSets:      client.AppsV1().DaemonSets(""),
-		Deployments:     client.AppsV1().Deployments(""),
-		Endpoints:       client.CoreV1().Endpoints(""),
-		Jobs:            client.BatchV1().Jobs(""),
-		LimitRanges:     client.CoreV1().LimitRanges(""),
-		Namespaces:      client.CoreV1().Namespaces(),
-		NetworkPolicies: client.NetworkingV1().NetworkPolicies(""),
-		Nodes:           client.CoreV1().Nodes(),
-		Pods:            client.CoreV1().Pods(""),
-		ReplicaSets:     client.AppsV1().ReplicaSets(""),
-		ResourceQuotas:  client.CoreV1().ResourceQuotas(""),
-		RoleBindings:    client.RbacV1().RoleBindings(""),
-		Roles:           client.RbacV1().Roles(""),
-		ServiceAccounts: client.CoreV1().ServiceAccounts(""),
-		Services:        client.CoreV1().Services(""),
-		StatefulSets:    client.AppsV1().StatefulSets(""),
+		Client:                            client,
+		APIServices:                       apiServicesClient{rest: client.Discovery().RESTClient()},
+		CertificateSigningRequests:        client.CertificatesV1().CertificateSigningRequests(),
+		ClusterRoleBindings:               client.RbacV1().ClusterRoleBindings(),
+		ClusterRoles:                      client.RbacV1().ClusterRoles(),
+		ControllerRevisions:               client.AppsV1().ControllerRevisions(""),
+		CronJobs:                          client.BatchV1().CronJobs(""),
+		DaemonSets:                        client.AppsV1().DaemonSets(""),
+		Deployments:                       client.AppsV1().Deployments(""),
+		Discovery:                         client.Discovery(),
+		EndpointSlices:                    client.DiscoveryV1().EndpointSlices(""),
+		Endpoints:                         client.CoreV1().Endpoints(""),
+		Events:                            client.CoreV1().Events(""),
+		EventsV1:                          client.EventsV1().Events(""),
+		HorizontalPodAutoscalers:          client.AutoscalingV2().HorizontalPodAutoscalers(""),
+		HorizontalPodAutoscalersV1:        client.AutoscalingV1().HorizontalPodAutoscalers(""),
+		Jobs:                              client.BatchV1().Jobs(""),
+		Leases:                            client.CoordinationV1().Leases(""),
+		LimitRanges:                       client.CoreV1().LimitRanges(""),
+		MutatingWebhookConfigurations:     client.AdmissionregistrationV1().MutatingWebhookConfigurations(),
+		Namespaces:                        client.CoreV1().Namespaces(),
+		NetworkPolicies:                   client.NetworkingV1().NetworkPolicies(""),
+		NodeMetrics:                       nodeMetricsClient{rest: client.Discovery().RESTClient()},
+		Nodes:                             client.CoreV1().Nodes(),
+		PodDisruptionBudgets:              client.PolicyV1().PodDisruptionBudgets(""),
+		PodMetrics:                        podMetricsClient{rest: client.Discovery().RESTClient()},
+		PodTemplates:                      client.CoreV1().PodTemplates(""),
+		Pods:                              client.CoreV1().Pods(""),
+		PriorityClasses:                   client.SchedulingV1().PriorityClasses(),
+		ReplicaSets:                       client.AppsV1().ReplicaSets(""),
+		ReplicationControllers:            client.CoreV1().ReplicationControllers(""),
+		ResourceQuotas:                    client.CoreV1().ResourceQuotas(""),
+		RoleBindings:                      client.RbacV1().RoleBindings(""),
+		Roles:                             client.RbacV1().Roles(""),
+		RuntimeClasses:                    client.NodeV1().RuntimeClasses(),
+		ServiceAccounts:                   client.CoreV1().ServiceAccounts(""),
+		Services:                          client.CoreV1().Services(""),
+		StatefulSets:                      client.AppsV1().StatefulSets(""),
+		ValidatingAdmissionPolicies:       validatingAdmissionPoliciesClient{api: admissionPolicies},
+		ValidatingAdmissionPolicyBindings: validatingAdmissionPolicyBindingsClient{api: admissionPolicies},
+		ValidatingWebhookConfigurations:   client.AdmissionregistrationV1().ValidatingWebhookConfigurations(),
 	}
 }
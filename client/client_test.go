@@ -0,0 +1,78 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestServerAndAuthMethod(t *testing.T) {
+	kConfig := api.Config{
+		Contexts: map[string]*api.Context{
+			"exec":  {Cluster: "eks", AuthInfo: "aws"},
+			"cert":  {Cluster: "kind", AuthInfo: "admin"},
+			"token": {Cluster: "kind", AuthInfo: "robot"},
+			"none":  {Cluster: "kind"},
+		},
+		Clusters: map[string]*api.Cluster{
+			"eks":  {Server: "https://eks.example.com"},
+			"kind": {Server: "https://127.0.0.1:6443"},
+		},
+		AuthInfos: map[string]*api.AuthInfo{
+			"aws":   {Exec: &api.ExecConfig{Command: "/usr/local/bin/aws"}},
+			"admin": {ClientCertificateData: []byte("cert")},
+			"robot": {Token: "secret"},
+		},
+	}
+	cases := []struct {
+		context, server, method, plugin string
+	}{
+		{"exec", "https://eks.example.com", "exec", "aws"},
+		{"cert", "https://127.0.0.1:6443", "client-certificate", ""},
+		{"token", "https://127.0.0.1:6443", "token", ""},
+		{"none", "https://127.0.0.1:6443", "none", ""},
+		{"missing", "", "", ""},
+	}
+	for _, tc := range cases {
+		c := &Client{kConfig: kConfig, Context: tc.context}
+		if server := c.Server(); server != tc.server {
+			t.Errorf("%s: expected server %q, got %q", tc.context, tc.server, server)
+		}
+		if method, plugin := c.AuthMethod(); method != tc.method || plugin != tc.plugin {
+			t.Errorf("%s: expected auth method %q/%q, got %q/%q", tc.context, tc.method, tc.plugin, method, plugin)
+		}
+	}
+}
+
+func TestEventsSeenSince(t *testing.T) {
+	type event struct {
+		name            string
+		created, series time.Time
+	}
+	now := time.Now()
+	events := []event{
+		{name: "old", created: now.Add(-3 * time.Hour)},
+		{name: "recurring", created: now.Add(-3 * time.Hour), series: now.Add(-time.Minute)},
+		{name: "recent", created: now.Add(-10 * time.Minute)},
+	}
+	lastSeen := func(e event) time.Time { return EventLastSeen(e.created, e.series) }
+
+	if got := EventsSeenSince(events, time.Time{}, lastSeen); len(got) != 3 {
+		t.Fatalf("expected all events without a cutoff, got %d", len(got))
+	}
+	got := EventsSeenSince(events, now.Add(-time.Hour), lastSeen)
+	if len(got) != 2 || got[0].name != "recurring" || got[1].name != "recent" {
+		t.Fatalf("unexpected events seen in the last hour: %v", got)
+	}
+}
+
+func TestEventsCutoff(t *testing.T) {
+	if cutoff := (&Client{}).EventsCutoff(); !cutoff.IsZero() {
+		t.Fatalf("expected no cutoff without a max age, got %s", cutoff)
+	}
+	c := &Client{config: &Config{EventsMaxAgeMinutes: 60}}
+	if cutoff := c.EventsCutoff(); time.Since(cutoff) < time.Hour || time.Since(cutoff) > time.Hour+time.Minute {
+		t.Fatalf("expected a cutoff an hour ago, got %s", cutoff)
+	}
+}
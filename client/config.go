@@ -2,6 +2,8 @@ package client
 
 type Config struct {
 	Contexts []string `hcl:"contexts,optional"`
+	// EventsMaxAgeMinutes limits the fetched events to the ones last seen within the given number of minutes.
+	EventsMaxAgeMinutes int `hcl:"events_max_age_minutes,optional"`
 }
 
 func (Config) Example() string {
@@ -10,5 +12,7 @@ Optional. Set contexts that you want to fetch. If it is not given then all conte
 contexts:
   - "YOUR_CONTEXT_NAME1"
   - "YOUR_CONTEXT_NAME2"
+Optional. Only fetch events last seen within the given number of minutes. If it is not given or 0 all events are fetched.
+events_max_age_minutes: 60
 `
 }
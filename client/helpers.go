@@ -2,9 +2,16 @@ package client
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"strings"
+	"time"
 
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -37,16 +44,15 @@ func ContextMultiplex(meta schema.ClientMeta) []schema.ClientMeta {
 }
 
 // APIFilterContextMultiplex returns a list of clients for each context from the cq config
-func APIFilterContextMultiplex(path string) func(meta schema.ClientMeta) []schema.ClientMeta {
+// if any of the given API paths is served.
+func APIFilterContextMultiplex(paths ...string) func(meta schema.ClientMeta) []schema.ClientMeta {
 	return func(meta schema.ClientMeta) []schema.ClientMeta {
 		client := meta.(*Client)
 
 		// in kubernetes version below 1.4 paths is nil
-		if client.paths != nil {
-			if _, ok := client.paths[path]; !ok {
-				client.Logger().Warn("The resource is not supported by current version of k8s", "path", path)
-				return []schema.ClientMeta{}
-			}
+		if client.paths != nil && !client.servesAny(paths) {
+			client.Logger().Warn("The resource is not supported by current version of k8s", "paths", paths)
+			return []schema.ClientMeta{}
 		}
 
 		clients := make([]schema.ClientMeta, 0, len(client.contexts))
@@ -57,6 +63,15 @@ func APIFilterContextMultiplex(path string) func(meta schema.ClientMeta) []schem
 	}
 }
 
+func (c *Client) servesAny(paths []string) bool {
+	for _, p := range paths {
+		if _, ok := c.paths[p]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // DeleteContextFilter returns a delete filter that cleans up the data belonging to the k8s context.
 func DeleteContextFilter(meta schema.ClientMeta, _ *schema.Resource) []interface{} {
 	client := meta.(*Client)
@@ -88,3 +103,134 @@ func OwnerReferenceResolver(ctx context.Context, meta schema.ClientMeta, parent
 	res <- refs
 	return nil
 }
+
+// TimeResolver resolves a metav1.Time or metav1.MicroTime field (or a pointer to one) into a timestamp.
+// Zero times are stored as null.
+func TimeResolver(path string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+		var t time.Time
+		switch v := funk.Get(r.Item, path, funk.WithAllowZero()).(type) {
+		case v1.Time:
+			t = v.Time
+		case *v1.Time:
+			if v != nil {
+				t = v.Time
+			}
+		case v1.MicroTime:
+			t = v.Time
+		case *v1.MicroTime:
+			if v != nil {
+				t = v.Time
+			}
+		}
+		if t.IsZero() {
+			return nil
+		}
+		return r.Set(c.Name, t)
+	}
+}
+
+// QuantityStringResolver resolves a resource.Quantity field (or a pointer to one) into its canonical string form.
+func QuantityStringResolver(path string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+		switch v := funk.Get(r.Item, path, funk.WithAllowZero()).(type) {
+		case resource.Quantity:
+			return r.Set(c.Name, v.String())
+		case *resource.Quantity:
+			if v != nil {
+				return r.Set(c.Name, v.String())
+			}
+		}
+		return nil
+	}
+}
+
+// ResourceListMilliValueResolver resolves the quantity of a resource in a corev1.ResourceList field in thousandths
+// of its unit, e.g. CPU millicores. The first of the names present in the list is used, resources missing from the
+// list are stored as null.
+func ResourceListMilliValueResolver(path string, names ...corev1.ResourceName) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+		q, ok := resourceListQuantity(r.Item, path, names)
+		if !ok {
+			return nil
+		}
+		return r.Set(c.Name, q.MilliValue())
+	}
+}
+
+// ResourceListValueResolver resolves the quantity of a resource in a corev1.ResourceList field in its unit rounded up,
+// e.g. memory bytes. The first of the names present in the list is used, resources missing from the list are stored
+// as null.
+func ResourceListValueResolver(path string, names ...corev1.ResourceName) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+		q, ok := resourceListQuantity(r.Item, path, names)
+		if !ok {
+			return nil
+		}
+		return r.Set(c.Name, q.Value())
+	}
+}
+
+// ResourceListGPUResolver resolves the number of GPUs in a corev1.ResourceList field, summed over the GPU extended
+// resources of every vendor (nvidia.com/gpu, amd.com/gpu, gpu.intel.com/i915...). Only the resources named with the
+// given prefix are considered, e.g. "requests." for resource quotas. Lists without GPUs are stored as null.
+func ResourceListGPUResolver(path string, prefix string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+		list, ok := funk.Get(r.Item, path, funk.WithAllowZero()).(corev1.ResourceList)
+		if !ok {
+			return nil
+		}
+		var total int64
+		var found bool
+		for name, q := range list {
+			if !strings.HasPrefix(string(name), prefix) || !IsGPUResource(strings.TrimPrefix(string(name), prefix)) {
+				continue
+			}
+			total += q.Value()
+			found = true
+		}
+		if !found {
+			return nil
+		}
+		return r.Set(c.Name, total)
+	}
+}
+
+// IsGPUResource reports whether the resource is a GPU extended resource, e.g. nvidia.com/gpu or gpu.intel.com/i915.
+func IsGPUResource(name string) bool {
+	i := strings.LastIndex(name, "/")
+	if i < 0 {
+		return false
+	}
+	return name[i+1:] == "gpu" || strings.HasPrefix(name, "gpu.")
+}
+
+func resourceListQuantity(item interface{}, path string, names []corev1.ResourceName) (resource.Quantity, bool) {
+	list, ok := funk.Get(item, path, funk.WithAllowZero()).(corev1.ResourceList)
+	if !ok {
+		return resource.Quantity{}, false
+	}
+	for _, name := range names {
+		if q, ok := list[name]; ok {
+			return q, true
+		}
+	}
+	return resource.Quantity{}, false
+}
+
+// CertificateFingerprints returns the hex encoded SHA-256 fingerprints of the PEM encoded certificates in the bundle.
+func CertificateFingerprints(bundle []byte) []string {
+	var fingerprints []string
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return fingerprints
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		sum := sha256.Sum256(block.Bytes)
+		fingerprints = append(fingerprints, hex.EncodeToString(sum[:]))
+	}
+}
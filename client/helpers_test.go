@@ -0,0 +1,80 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestCertificateFingerprints(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test-ca"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(der)
+	want := hex.EncodeToString(sum[:])
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	other := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("not a certificate")})
+	bundle := append(append(append([]byte{}, cert...), other...), cert...)
+
+	got := CertificateFingerprints(bundle)
+	if len(got) != 2 || got[0] != want || got[1] != want {
+		t.Fatalf("expected two fingerprints %q, got %v", want, got)
+	}
+	if got := CertificateFingerprints(nil); len(got) != 0 {
+		t.Fatalf("expected no fingerprints for an empty bundle, got %v", got)
+	}
+}
+
+func TestIsGPUResource(t *testing.T) {
+	for name, want := range map[string]bool{
+		"nvidia.com/gpu":     true,
+		"amd.com/gpu":        true,
+		"gpu.intel.com/i915": true,
+		"cpu":                false,
+		"hugepages-2Mi":      false,
+		"example.com/foo":    false,
+	} {
+		if got := IsGPUResource(name); got != want {
+			t.Errorf("IsGPUResource(%q) = %v, expected %v", name, got, want)
+		}
+	}
+}
+
+func TestResourceListQuantity(t *testing.T) {
+	item := corev1.ResourceQuota{Status: corev1.ResourceQuotaStatus{Hard: corev1.ResourceList{
+		corev1.ResourceCPU:          resource.MustParse("2"),
+		corev1.ResourceLimitsMemory: resource.MustParse("1Gi"),
+	}}}
+	if q, ok := resourceListQuantity(item, "Status.Hard", []corev1.ResourceName{corev1.ResourceRequestsCPU, corev1.ResourceCPU}); !ok || q.MilliValue() != 2000 {
+		t.Fatalf("expected the fallback name to be used, got %v %v", q, ok)
+	}
+	if q, ok := resourceListQuantity(item, "Status.Hard", []corev1.ResourceName{corev1.ResourceLimitsMemory}); !ok || q.Value() != 1<<30 {
+		t.Fatalf("expected 1Gi, got %v %v", q, ok)
+	}
+	if _, ok := resourceListQuantity(item, "Status.Used", []corev1.ResourceName{corev1.ResourceCPU}); ok {
+		t.Fatal("expected missing list to be reported")
+	}
+}
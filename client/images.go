@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/thoas/go-funk"
+)
+
+const defaultRegistry = "docker.io"
+
+// ImageReference is a container image reference split into its components, normalized the way container runtimes
+// do: images without a registry are pulled from docker.io, official images live in its library/ namespace and
+// images referenced by neither a tag nor a digest resolve to the latest tag.
+type ImageReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// ParseImageReference parses a container image reference such as registry:5000/team/app:1.0@sha256:abc.
+func ParseImageReference(image string) ImageReference {
+	var ref ImageReference
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.Digest = name[:i], name[i+1:]
+	}
+	if i := strings.LastIndex(name, ":"); i >= 0 && !strings.Contains(name[i+1:], "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+	}
+	ref.Registry, ref.Repository = defaultRegistry, name
+	if i := strings.Index(name, "/"); i >= 0 {
+		if host := name[:i]; strings.ContainsAny(host, ".:") || host == "localhost" {
+			ref.Registry, ref.Repository = host, name[i+1:]
+		}
+	}
+	if ref.Registry == defaultRegistry && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+	return ref
+}
+
+// ImageIDDigest returns the digest of an image ID reported in a container status, e.g. sha256:abc for
+// docker-pullable://nginx@sha256:abc. Empty if the ID doesn't contain a digest.
+func ImageIDDigest(imageID string) string {
+	if i := strings.LastIndex(imageID, "@"); i >= 0 {
+		return imageID[i+1:]
+	}
+	if strings.HasPrefix(imageID, "sha256:") {
+		return imageID
+	}
+	return ""
+}
+
+// ImageReferenceResolver resolves a component of the image reference held by the string field at path. Empty
+// components are stored as null.
+func ImageReferenceResolver(path string, component func(ImageReference) string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+		image, ok := funk.Get(r.Item, path, funk.WithAllowZero()).(string)
+		if !ok || image == "" {
+			return nil
+		}
+		v := component(ParseImageReference(image))
+		if v == "" {
+			return nil
+		}
+		return diag.WrapError(r.Set(c.Name, v))
+	}
+}
+
+// ImagePinnedByDigestResolver resolves whether the image reference held by the string field at path has a digest.
+func ImagePinnedByDigestResolver(path string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+		image, ok := funk.Get(r.Item, path, funk.WithAllowZero()).(string)
+		if !ok || image == "" {
+			return nil
+		}
+		return diag.WrapError(r.Set(c.Name, ParseImageReference(image).Digest != ""))
+	}
+}
@@ -0,0 +1,36 @@
+package client
+
+import "testing"
+
+func TestParseImageReference(t *testing.T) {
+	cases := map[string]ImageReference{
+		"nginx":                              {Registry: "docker.io", Repository: "library/nginx", Tag: "latest"},
+		"nginx:1.23.1":                       {Registry: "docker.io", Repository: "library/nginx", Tag: "1.23.1"},
+		"bitnami/redis:7.0":                  {Registry: "docker.io", Repository: "bitnami/redis", Tag: "7.0"},
+		"gcr.io/distroless/static@sha256:ab": {Registry: "gcr.io", Repository: "distroless/static", Digest: "sha256:ab"},
+		"localhost:5000/app:v1@sha256:cd":    {Registry: "localhost:5000", Repository: "app", Tag: "v1", Digest: "sha256:cd"},
+		"localhost/app":                      {Registry: "localhost", Repository: "app", Tag: "latest"},
+		"registry.k8s.io/pause:3.7":          {Registry: "registry.k8s.io", Repository: "pause", Tag: "3.7"},
+		"123.dkr.ecr.us-east-1.amazonaws.com/team/svc:2022-10": {Registry: "123.dkr.ecr.us-east-1.amazonaws.com", Repository: "team/svc", Tag: "2022-10"},
+	}
+	for image, want := range cases {
+		if got := ParseImageReference(image); got != want {
+			t.Errorf("ParseImageReference(%q) = %+v, expected %+v", image, got, want)
+		}
+	}
+}
+
+func TestImageIDDigest(t *testing.T) {
+	cases := map[string]string{
+		"docker-pullable://nginx@sha256:abc": "sha256:abc",
+		"docker.io/library/nginx@sha256:abc": "sha256:abc",
+		"sha256:def":                         "sha256:def",
+		"docker://sha256:def":                "",
+		"":                                   "",
+	}
+	for id, want := range cases {
+		if got := ImageIDDigest(id); got != want {
+			t.Errorf("ImageIDDigest(%q) = %q, expected %q", id, got, want)
+		}
+	}
+}
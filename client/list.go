@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListAll calls a list function of a service until the server returns no continue token and returns the items of
+// every page, e.g. ListAll(ctx, svc.Pods.List, func(l *corev1.PodList) []corev1.Pod { return l.Items }).
+func ListAll[L interface{ GetContinue() string }, T any](ctx context.Context, list func(ctx context.Context, opts metav1.ListOptions) (L, error), items func(L) []T) ([]T, error) {
+	var all []T
+	opts := metav1.ListOptions{}
+	for {
+		result, err := list(ctx, opts)
+		if err != nil {
+			return nil, diag.WrapError(err)
+		}
+		all = append(all, items(result)...)
+		if result.GetContinue() == "" {
+			return all, nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestListAll(t *testing.T) {
+	pages := map[string]*corev1.PodList{
+		"": {
+			ListMeta: metav1.ListMeta{Continue: "page-2"},
+			Items:    []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "a"}}},
+		},
+		"page-2": {
+			Items: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "b"}}, {ObjectMeta: metav1.ObjectMeta{Name: "c"}}},
+		},
+	}
+	list := func(_ context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+		return pages[opts.Continue], nil
+	}
+	pods, err := ListAll(context.Background(), list, func(l *corev1.PodList) []corev1.Pod { return l.Items })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 3 || pods[0].Name != "a" || pods[2].Name != "c" {
+		t.Fatalf("unexpected pods %v", pods)
+	}
+}
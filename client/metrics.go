@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// The metrics.k8s.io types live in k8s.io/metrics, which the provider doesn't depend on, so the subset of the
+// types read by the provider is declared here.
+
+const (
+	PodMetricsPath  = "/apis/metrics.k8s.io/v1beta1/pods"
+	NodeMetricsPath = "/apis/metrics.k8s.io/v1beta1/nodes"
+)
+
+type PodMetricsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodMetrics `json:"items"`
+}
+
+type PodMetrics struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Timestamp         metav1.Time        `json:"timestamp"`
+	Window            metav1.Duration    `json:"window"`
+	Containers        []ContainerMetrics `json:"containers"`
+}
+
+type ContainerMetrics struct {
+	Name  string              `json:"name"`
+	Usage corev1.ResourceList `json:"usage"`
+}
+
+type NodeMetricsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeMetrics `json:"items"`
+}
+
+type NodeMetrics struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Timestamp         metav1.Time         `json:"timestamp"`
+	Window            metav1.Duration     `json:"window"`
+	Usage             corev1.ResourceList `json:"usage"`
+}
+
+type podMetricsClient struct {
+	rest rest.Interface
+}
+
+func (c podMetricsClient) List(ctx context.Context, opts metav1.ListOptions) (*PodMetricsList, error) {
+	var list PodMetricsList
+	if err := listPath(ctx, c.rest, PodMetricsPath, opts, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+type nodeMetricsClient struct {
+	rest rest.Interface
+}
+
+func (c nodeMetricsClient) List(ctx context.Context, opts metav1.ListOptions) (*NodeMetricsList, error) {
+	var list NodeMetricsList
+	if err := listPath(ctx, c.rest, NodeMetricsPath, opts, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
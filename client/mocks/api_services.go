@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: APIServicesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/cloudquery/cq-provider-k8s/client"
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockAPIServicesClient is a mock of APIServicesClient interface.
+type MockAPIServicesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIServicesClientMockRecorder
+}
+
+// MockAPIServicesClientMockRecorder is the mock recorder for MockAPIServicesClient.
+type MockAPIServicesClientMockRecorder struct {
+	mock *MockAPIServicesClient
+}
+
+// NewMockAPIServicesClient creates a new mock instance.
+func NewMockAPIServicesClient(ctrl *gomock.Controller) *MockAPIServicesClient {
+	mock := &MockAPIServicesClient{ctrl: ctrl}
+	mock.recorder = &MockAPIServicesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIServicesClient) EXPECT() *MockAPIServicesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockAPIServicesClient) List(arg0 context.Context, arg1 v1.ListOptions) (*client.APIServiceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*client.APIServiceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPIServicesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIServicesClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: CertificateSigningRequestsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/certificates/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockCertificateSigningRequestsClient is a mock of CertificateSigningRequestsClient interface.
+type MockCertificateSigningRequestsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCertificateSigningRequestsClientMockRecorder
+}
+
+// MockCertificateSigningRequestsClientMockRecorder is the mock recorder for MockCertificateSigningRequestsClient.
+type MockCertificateSigningRequestsClientMockRecorder struct {
+	mock *MockCertificateSigningRequestsClient
+}
+
+// NewMockCertificateSigningRequestsClient creates a new mock instance.
+func NewMockCertificateSigningRequestsClient(ctrl *gomock.Controller) *MockCertificateSigningRequestsClient {
+	mock := &MockCertificateSigningRequestsClient{ctrl: ctrl}
+	mock.recorder = &MockCertificateSigningRequestsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCertificateSigningRequestsClient) EXPECT() *MockCertificateSigningRequestsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockCertificateSigningRequestsClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.CertificateSigningRequestList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.CertificateSigningRequestList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCertificateSigningRequestsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCertificateSigningRequestsClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: ClusterRoleBindingsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/rbac/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockClusterRoleBindingsClient is a mock of ClusterRoleBindingsClient interface.
+type MockClusterRoleBindingsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterRoleBindingsClientMockRecorder
+}
+
+// MockClusterRoleBindingsClientMockRecorder is the mock recorder for MockClusterRoleBindingsClient.
+type MockClusterRoleBindingsClientMockRecorder struct {
+	mock *MockClusterRoleBindingsClient
+}
+
+// NewMockClusterRoleBindingsClient creates a new mock instance.
+func NewMockClusterRoleBindingsClient(ctrl *gomock.Controller) *MockClusterRoleBindingsClient {
+	mock := &MockClusterRoleBindingsClient{ctrl: ctrl}
+	mock.recorder = &MockClusterRoleBindingsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterRoleBindingsClient) EXPECT() *MockClusterRoleBindingsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockClusterRoleBindingsClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.ClusterRoleBindingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ClusterRoleBindingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockClusterRoleBindingsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClusterRoleBindingsClient)(nil).List), arg0, arg1)
+}
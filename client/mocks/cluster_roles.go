@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: ClusterRolesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/rbac/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockClusterRolesClient is a mock of ClusterRolesClient interface.
+type MockClusterRolesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterRolesClientMockRecorder
+}
+
+// MockClusterRolesClientMockRecorder is the mock recorder for MockClusterRolesClient.
+type MockClusterRolesClientMockRecorder struct {
+	mock *MockClusterRolesClient
+}
+
+// NewMockClusterRolesClient creates a new mock instance.
+func NewMockClusterRolesClient(ctrl *gomock.Controller) *MockClusterRolesClient {
+	mock := &MockClusterRolesClient{ctrl: ctrl}
+	mock.recorder = &MockClusterRolesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterRolesClient) EXPECT() *MockClusterRolesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockClusterRolesClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.ClusterRoleList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ClusterRoleList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockClusterRolesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClusterRolesClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: ControllerRevisionsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/apps/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockControllerRevisionsClient is a mock of ControllerRevisionsClient interface.
+type MockControllerRevisionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerRevisionsClientMockRecorder
+}
+
+// MockControllerRevisionsClientMockRecorder is the mock recorder for MockControllerRevisionsClient.
+type MockControllerRevisionsClientMockRecorder struct {
+	mock *MockControllerRevisionsClient
+}
+
+// NewMockControllerRevisionsClient creates a new mock instance.
+func NewMockControllerRevisionsClient(ctrl *gomock.Controller) *MockControllerRevisionsClient {
+	mock := &MockControllerRevisionsClient{ctrl: ctrl}
+	mock.recorder = &MockControllerRevisionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerRevisionsClient) EXPECT() *MockControllerRevisionsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockControllerRevisionsClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.ControllerRevisionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ControllerRevisionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockControllerRevisionsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockControllerRevisionsClient)(nil).List), arg0, arg1)
+}
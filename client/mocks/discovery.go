@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: DiscoveryClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	version "k8s.io/apimachinery/pkg/version"
+)
+
+// MockDiscoveryClient is a mock of DiscoveryClient interface.
+type MockDiscoveryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiscoveryClientMockRecorder
+}
+
+// MockDiscoveryClientMockRecorder is the mock recorder for MockDiscoveryClient.
+type MockDiscoveryClientMockRecorder struct {
+	mock *MockDiscoveryClient
+}
+
+// NewMockDiscoveryClient creates a new mock instance.
+func NewMockDiscoveryClient(ctrl *gomock.Controller) *MockDiscoveryClient {
+	mock := &MockDiscoveryClient{ctrl: ctrl}
+	mock.recorder = &MockDiscoveryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiscoveryClient) EXPECT() *MockDiscoveryClientMockRecorder {
+	return m.recorder
+}
+
+// ServerGroups mocks base method.
+func (m *MockDiscoveryClient) ServerGroups() (*v1.APIGroupList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerGroups")
+	ret0, _ := ret[0].(*v1.APIGroupList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerGroups indicates an expected call of ServerGroups.
+func (mr *MockDiscoveryClientMockRecorder) ServerGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerGroups", reflect.TypeOf((*MockDiscoveryClient)(nil).ServerGroups))
+}
+
+// ServerGroupsAndResources mocks base method.
+func (m *MockDiscoveryClient) ServerGroupsAndResources() ([]*v1.APIGroup, []*v1.APIResourceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerGroupsAndResources")
+	ret0, _ := ret[0].([]*v1.APIGroup)
+	ret1, _ := ret[1].([]*v1.APIResourceList)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ServerGroupsAndResources indicates an expected call of ServerGroupsAndResources.
+func (mr *MockDiscoveryClientMockRecorder) ServerGroupsAndResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerGroupsAndResources", reflect.TypeOf((*MockDiscoveryClient)(nil).ServerGroupsAndResources))
+}
+
+// ServerVersion mocks base method.
+func (m *MockDiscoveryClient) ServerVersion() (*version.Info, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerVersion")
+	ret0, _ := ret[0].(*version.Info)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerVersion indicates an expected call of ServerVersion.
+func (mr *MockDiscoveryClientMockRecorder) ServerVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerVersion", reflect.TypeOf((*MockDiscoveryClient)(nil).ServerVersion))
+}
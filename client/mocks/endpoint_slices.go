@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: EndpointSlicesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/discovery/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockEndpointSlicesClient is a mock of EndpointSlicesClient interface.
+type MockEndpointSlicesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndpointSlicesClientMockRecorder
+}
+
+// MockEndpointSlicesClientMockRecorder is the mock recorder for MockEndpointSlicesClient.
+type MockEndpointSlicesClientMockRecorder struct {
+	mock *MockEndpointSlicesClient
+}
+
+// NewMockEndpointSlicesClient creates a new mock instance.
+func NewMockEndpointSlicesClient(ctrl *gomock.Controller) *MockEndpointSlicesClient {
+	mock := &MockEndpointSlicesClient{ctrl: ctrl}
+	mock.recorder = &MockEndpointSlicesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndpointSlicesClient) EXPECT() *MockEndpointSlicesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockEndpointSlicesClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.EndpointSliceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.EndpointSliceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEndpointSlicesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEndpointSlicesClient)(nil).List), arg0, arg1)
+}
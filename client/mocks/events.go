@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: EventsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockEventsClient is a mock of EventsClient interface.
+type MockEventsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsClientMockRecorder
+}
+
+// MockEventsClientMockRecorder is the mock recorder for MockEventsClient.
+type MockEventsClientMockRecorder struct {
+	mock *MockEventsClient
+}
+
+// NewMockEventsClient creates a new mock instance.
+func NewMockEventsClient(ctrl *gomock.Controller) *MockEventsClient {
+	mock := &MockEventsClient{ctrl: ctrl}
+	mock.recorder = &MockEventsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsClient) EXPECT() *MockEventsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockEventsClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.EventList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.EventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEventsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEventsClient)(nil).List), arg0, arg1)
+}
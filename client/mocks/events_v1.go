@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: EventsV1Client)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/events/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockEventsV1Client is a mock of EventsV1Client interface.
+type MockEventsV1Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsV1ClientMockRecorder
+}
+
+// MockEventsV1ClientMockRecorder is the mock recorder for MockEventsV1Client.
+type MockEventsV1ClientMockRecorder struct {
+	mock *MockEventsV1Client
+}
+
+// NewMockEventsV1Client creates a new mock instance.
+func NewMockEventsV1Client(ctrl *gomock.Controller) *MockEventsV1Client {
+	mock := &MockEventsV1Client{ctrl: ctrl}
+	mock.recorder = &MockEventsV1ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsV1Client) EXPECT() *MockEventsV1ClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockEventsV1Client) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.EventList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.EventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEventsV1ClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEventsV1Client)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: HorizontalPodAutoscalersClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockHorizontalPodAutoscalersClient is a mock of HorizontalPodAutoscalersClient interface.
+type MockHorizontalPodAutoscalersClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHorizontalPodAutoscalersClientMockRecorder
+}
+
+// MockHorizontalPodAutoscalersClientMockRecorder is the mock recorder for MockHorizontalPodAutoscalersClient.
+type MockHorizontalPodAutoscalersClientMockRecorder struct {
+	mock *MockHorizontalPodAutoscalersClient
+}
+
+// NewMockHorizontalPodAutoscalersClient creates a new mock instance.
+func NewMockHorizontalPodAutoscalersClient(ctrl *gomock.Controller) *MockHorizontalPodAutoscalersClient {
+	mock := &MockHorizontalPodAutoscalersClient{ctrl: ctrl}
+	mock.recorder = &MockHorizontalPodAutoscalersClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHorizontalPodAutoscalersClient) EXPECT() *MockHorizontalPodAutoscalersClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockHorizontalPodAutoscalersClient) List(arg0 context.Context, arg1 v1.ListOptions) (*v2.HorizontalPodAutoscalerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v2.HorizontalPodAutoscalerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockHorizontalPodAutoscalersClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockHorizontalPodAutoscalersClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: HorizontalPodAutoscalersV1Client)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/autoscaling/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockHorizontalPodAutoscalersV1Client is a mock of HorizontalPodAutoscalersV1Client interface.
+type MockHorizontalPodAutoscalersV1Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockHorizontalPodAutoscalersV1ClientMockRecorder
+}
+
+// MockHorizontalPodAutoscalersV1ClientMockRecorder is the mock recorder for MockHorizontalPodAutoscalersV1Client.
+type MockHorizontalPodAutoscalersV1ClientMockRecorder struct {
+	mock *MockHorizontalPodAutoscalersV1Client
+}
+
+// NewMockHorizontalPodAutoscalersV1Client creates a new mock instance.
+func NewMockHorizontalPodAutoscalersV1Client(ctrl *gomock.Controller) *MockHorizontalPodAutoscalersV1Client {
+	mock := &MockHorizontalPodAutoscalersV1Client{ctrl: ctrl}
+	mock.recorder = &MockHorizontalPodAutoscalersV1ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHorizontalPodAutoscalersV1Client) EXPECT() *MockHorizontalPodAutoscalersV1ClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockHorizontalPodAutoscalersV1Client) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.HorizontalPodAutoscalerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.HorizontalPodAutoscalerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockHorizontalPodAutoscalersV1ClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockHorizontalPodAutoscalersV1Client)(nil).List), arg0, arg1)
+}
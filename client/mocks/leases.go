@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: LeasesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/coordination/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockLeasesClient is a mock of LeasesClient interface.
+type MockLeasesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeasesClientMockRecorder
+}
+
+// MockLeasesClientMockRecorder is the mock recorder for MockLeasesClient.
+type MockLeasesClientMockRecorder struct {
+	mock *MockLeasesClient
+}
+
+// NewMockLeasesClient creates a new mock instance.
+func NewMockLeasesClient(ctrl *gomock.Controller) *MockLeasesClient {
+	mock := &MockLeasesClient{ctrl: ctrl}
+	mock.recorder = &MockLeasesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeasesClient) EXPECT() *MockLeasesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockLeasesClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.LeaseList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.LeaseList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLeasesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLeasesClient)(nil).List), arg0, arg1)
+}
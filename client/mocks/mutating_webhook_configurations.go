@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: MutatingWebhookConfigurationsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/admissionregistration/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockMutatingWebhookConfigurationsClient is a mock of MutatingWebhookConfigurationsClient interface.
+type MockMutatingWebhookConfigurationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMutatingWebhookConfigurationsClientMockRecorder
+}
+
+// MockMutatingWebhookConfigurationsClientMockRecorder is the mock recorder for MockMutatingWebhookConfigurationsClient.
+type MockMutatingWebhookConfigurationsClientMockRecorder struct {
+	mock *MockMutatingWebhookConfigurationsClient
+}
+
+// NewMockMutatingWebhookConfigurationsClient creates a new mock instance.
+func NewMockMutatingWebhookConfigurationsClient(ctrl *gomock.Controller) *MockMutatingWebhookConfigurationsClient {
+	mock := &MockMutatingWebhookConfigurationsClient{ctrl: ctrl}
+	mock.recorder = &MockMutatingWebhookConfigurationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMutatingWebhookConfigurationsClient) EXPECT() *MockMutatingWebhookConfigurationsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockMutatingWebhookConfigurationsClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.MutatingWebhookConfigurationList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.MutatingWebhookConfigurationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMutatingWebhookConfigurationsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMutatingWebhookConfigurationsClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: NodeMetricsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/cloudquery/cq-provider-k8s/client"
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockNodeMetricsClient is a mock of NodeMetricsClient interface.
+type MockNodeMetricsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodeMetricsClientMockRecorder
+}
+
+// MockNodeMetricsClientMockRecorder is the mock recorder for MockNodeMetricsClient.
+type MockNodeMetricsClientMockRecorder struct {
+	mock *MockNodeMetricsClient
+}
+
+// NewMockNodeMetricsClient creates a new mock instance.
+func NewMockNodeMetricsClient(ctrl *gomock.Controller) *MockNodeMetricsClient {
+	mock := &MockNodeMetricsClient{ctrl: ctrl}
+	mock.recorder = &MockNodeMetricsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNodeMetricsClient) EXPECT() *MockNodeMetricsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockNodeMetricsClient) List(arg0 context.Context, arg1 v1.ListOptions) (*client.NodeMetricsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*client.NodeMetricsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockNodeMetricsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNodeMetricsClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: PodDisruptionBudgetsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/policy/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockPodDisruptionBudgetsClient is a mock of PodDisruptionBudgetsClient interface.
+type MockPodDisruptionBudgetsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPodDisruptionBudgetsClientMockRecorder
+}
+
+// MockPodDisruptionBudgetsClientMockRecorder is the mock recorder for MockPodDisruptionBudgetsClient.
+type MockPodDisruptionBudgetsClientMockRecorder struct {
+	mock *MockPodDisruptionBudgetsClient
+}
+
+// NewMockPodDisruptionBudgetsClient creates a new mock instance.
+func NewMockPodDisruptionBudgetsClient(ctrl *gomock.Controller) *MockPodDisruptionBudgetsClient {
+	mock := &MockPodDisruptionBudgetsClient{ctrl: ctrl}
+	mock.recorder = &MockPodDisruptionBudgetsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPodDisruptionBudgetsClient) EXPECT() *MockPodDisruptionBudgetsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockPodDisruptionBudgetsClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.PodDisruptionBudgetList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.PodDisruptionBudgetList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPodDisruptionBudgetsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPodDisruptionBudgetsClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: PodMetricsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/cloudquery/cq-provider-k8s/client"
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockPodMetricsClient is a mock of PodMetricsClient interface.
+type MockPodMetricsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPodMetricsClientMockRecorder
+}
+
+// MockPodMetricsClientMockRecorder is the mock recorder for MockPodMetricsClient.
+type MockPodMetricsClientMockRecorder struct {
+	mock *MockPodMetricsClient
+}
+
+// NewMockPodMetricsClient creates a new mock instance.
+func NewMockPodMetricsClient(ctrl *gomock.Controller) *MockPodMetricsClient {
+	mock := &MockPodMetricsClient{ctrl: ctrl}
+	mock.recorder = &MockPodMetricsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPodMetricsClient) EXPECT() *MockPodMetricsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockPodMetricsClient) List(arg0 context.Context, arg1 v1.ListOptions) (*client.PodMetricsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*client.PodMetricsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPodMetricsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPodMetricsClient)(nil).List), arg0, arg1)
+}
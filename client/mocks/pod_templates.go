@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: PodTemplatesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockPodTemplatesClient is a mock of PodTemplatesClient interface.
+type MockPodTemplatesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPodTemplatesClientMockRecorder
+}
+
+// MockPodTemplatesClientMockRecorder is the mock recorder for MockPodTemplatesClient.
+type MockPodTemplatesClientMockRecorder struct {
+	mock *MockPodTemplatesClient
+}
+
+// NewMockPodTemplatesClient creates a new mock instance.
+func NewMockPodTemplatesClient(ctrl *gomock.Controller) *MockPodTemplatesClient {
+	mock := &MockPodTemplatesClient{ctrl: ctrl}
+	mock.recorder = &MockPodTemplatesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPodTemplatesClient) EXPECT() *MockPodTemplatesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockPodTemplatesClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.PodTemplateList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.PodTemplateList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPodTemplatesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPodTemplatesClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: PriorityClassesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/scheduling/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockPriorityClassesClient is a mock of PriorityClassesClient interface.
+type MockPriorityClassesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriorityClassesClientMockRecorder
+}
+
+// MockPriorityClassesClientMockRecorder is the mock recorder for MockPriorityClassesClient.
+type MockPriorityClassesClientMockRecorder struct {
+	mock *MockPriorityClassesClient
+}
+
+// NewMockPriorityClassesClient creates a new mock instance.
+func NewMockPriorityClassesClient(ctrl *gomock.Controller) *MockPriorityClassesClient {
+	mock := &MockPriorityClassesClient{ctrl: ctrl}
+	mock.recorder = &MockPriorityClassesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriorityClassesClient) EXPECT() *MockPriorityClassesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockPriorityClassesClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.PriorityClassList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.PriorityClassList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPriorityClassesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPriorityClassesClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: ReplicationControllersClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockReplicationControllersClient is a mock of ReplicationControllersClient interface.
+type MockReplicationControllersClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplicationControllersClientMockRecorder
+}
+
+// MockReplicationControllersClientMockRecorder is the mock recorder for MockReplicationControllersClient.
+type MockReplicationControllersClientMockRecorder struct {
+	mock *MockReplicationControllersClient
+}
+
+// NewMockReplicationControllersClient creates a new mock instance.
+func NewMockReplicationControllersClient(ctrl *gomock.Controller) *MockReplicationControllersClient {
+	mock := &MockReplicationControllersClient{ctrl: ctrl}
+	mock.recorder = &MockReplicationControllersClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReplicationControllersClient) EXPECT() *MockReplicationControllersClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockReplicationControllersClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.ReplicationControllerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ReplicationControllerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReplicationControllersClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReplicationControllersClient)(nil).List), arg0, arg1)
+}
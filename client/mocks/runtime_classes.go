@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: RuntimeClassesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/node/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockRuntimeClassesClient is a mock of RuntimeClassesClient interface.
+type MockRuntimeClassesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRuntimeClassesClientMockRecorder
+}
+
+// MockRuntimeClassesClientMockRecorder is the mock recorder for MockRuntimeClassesClient.
+type MockRuntimeClassesClientMockRecorder struct {
+	mock *MockRuntimeClassesClient
+}
+
+// NewMockRuntimeClassesClient creates a new mock instance.
+func NewMockRuntimeClassesClient(ctrl *gomock.Controller) *MockRuntimeClassesClient {
+	mock := &MockRuntimeClassesClient{ctrl: ctrl}
+	mock.recorder = &MockRuntimeClassesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRuntimeClassesClient) EXPECT() *MockRuntimeClassesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockRuntimeClassesClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.RuntimeClassList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RuntimeClassList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRuntimeClassesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRuntimeClassesClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: ValidatingAdmissionPoliciesClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/cloudquery/cq-provider-k8s/client"
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockValidatingAdmissionPoliciesClient is a mock of ValidatingAdmissionPoliciesClient interface.
+type MockValidatingAdmissionPoliciesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatingAdmissionPoliciesClientMockRecorder
+}
+
+// MockValidatingAdmissionPoliciesClientMockRecorder is the mock recorder for MockValidatingAdmissionPoliciesClient.
+type MockValidatingAdmissionPoliciesClientMockRecorder struct {
+	mock *MockValidatingAdmissionPoliciesClient
+}
+
+// NewMockValidatingAdmissionPoliciesClient creates a new mock instance.
+func NewMockValidatingAdmissionPoliciesClient(ctrl *gomock.Controller) *MockValidatingAdmissionPoliciesClient {
+	mock := &MockValidatingAdmissionPoliciesClient{ctrl: ctrl}
+	mock.recorder = &MockValidatingAdmissionPoliciesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidatingAdmissionPoliciesClient) EXPECT() *MockValidatingAdmissionPoliciesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockValidatingAdmissionPoliciesClient) List(arg0 context.Context, arg1 v1.ListOptions) (*client.ValidatingAdmissionPolicyList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*client.ValidatingAdmissionPolicyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockValidatingAdmissionPoliciesClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockValidatingAdmissionPoliciesClient)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: ValidatingAdmissionPolicyBindingsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/cloudquery/cq-provider-k8s/client"
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockValidatingAdmissionPolicyBindingsClient is a mock of ValidatingAdmissionPolicyBindingsClient interface.
+type MockValidatingAdmissionPolicyBindingsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatingAdmissionPolicyBindingsClientMockRecorder
+}
+
+// MockValidatingAdmissionPolicyBindingsClientMockRecorder is the mock recorder for MockValidatingAdmissionPolicyBindingsClient.
+type MockValidatingAdmissionPolicyBindingsClientMockRecorder struct {
+	mock *MockValidatingAdmissionPolicyBindingsClient
+}
+
+// NewMockValidatingAdmissionPolicyBindingsClient creates a new mock instance.
+func NewMockValidatingAdmissionPolicyBindingsClient(ctrl *gomock.Controller) *MockValidatingAdmissionPolicyBindingsClient {
+	mock := &MockValidatingAdmissionPolicyBindingsClient{ctrl: ctrl}
+	mock.recorder = &MockValidatingAdmissionPolicyBindingsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidatingAdmissionPolicyBindingsClient) EXPECT() *MockValidatingAdmissionPolicyBindingsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockValidatingAdmissionPolicyBindingsClient) List(arg0 context.Context, arg1 v1.ListOptions) (*client.ValidatingAdmissionPolicyBindingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*client.ValidatingAdmissionPolicyBindingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockValidatingAdmissionPolicyBindingsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockValidatingAdmissionPolicyBindingsClient)(nil).List), arg0, arg1)
+}
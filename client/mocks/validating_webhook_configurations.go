@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cloudquery/cq-provider-k8s/client (interfaces: ValidatingWebhookConfigurationsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/admissionregistration/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MockValidatingWebhookConfigurationsClient is a mock of ValidatingWebhookConfigurationsClient interface.
+type MockValidatingWebhookConfigurationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatingWebhookConfigurationsClientMockRecorder
+}
+
+// MockValidatingWebhookConfigurationsClientMockRecorder is the mock recorder for MockValidatingWebhookConfigurationsClient.
+type MockValidatingWebhookConfigurationsClientMockRecorder struct {
+	mock *MockValidatingWebhookConfigurationsClient
+}
+
+// NewMockValidatingWebhookConfigurationsClient creates a new mock instance.
+func NewMockValidatingWebhookConfigurationsClient(ctrl *gomock.Controller) *MockValidatingWebhookConfigurationsClient {
+	mock := &MockValidatingWebhookConfigurationsClient{ctrl: ctrl}
+	mock.recorder = &MockValidatingWebhookConfigurationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidatingWebhookConfigurationsClient) EXPECT() *MockValidatingWebhookConfigurationsClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockValidatingWebhookConfigurationsClient) List(arg0 context.Context, arg1 v10.ListOptions) (*v1.ValidatingWebhookConfigurationList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatingWebhookConfigurationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockValidatingWebhookConfigurationsClientMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockValidatingWebhookConfigurationsClient)(nil).List), arg0, arg1)
+}
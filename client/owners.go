@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/thoas/go-funk"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// OwnerIndex maps the UIDs of the controllers of a context to their owner references, so that owner references
+// can be followed up to the top-level owner of an object.
+type OwnerIndex struct {
+	owners map[types.UID][]metav1.OwnerReference
+}
+
+// Root follows the given owner references of an object up to its top-level owner. At each level the managing
+// controller is followed, or the first owner if none of them is marked as controller. Owners that aren't indexed,
+// such as custom resources like Argo Rollouts, end the walk and are returned as the top-level owner.
+func (i *OwnerIndex) Root(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	ref, ok := controllerRef(refs)
+	if !ok {
+		return metav1.OwnerReference{}, false
+	}
+	visited := map[types.UID]bool{ref.UID: true}
+	for {
+		next, ok := controllerRef(i.owners[ref.UID])
+		if !ok || visited[next.UID] {
+			return ref, true
+		}
+		visited[next.UID] = true
+		ref = next
+	}
+}
+
+func controllerRef(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	if len(refs) == 0 {
+		return metav1.OwnerReference{}, false
+	}
+	for _, r := range refs {
+		if r.Controller != nil && *r.Controller {
+			return r, true
+		}
+	}
+	return refs[0], true
+}
+
+type ownerIndexEntry struct {
+	once  sync.Once
+	index *OwnerIndex
+	err   error
+}
+
+// ownerIndexes holds the owner index of each context, built once on first use.
+type ownerIndexes struct {
+	mu      sync.Mutex
+	entries map[string]*ownerIndexEntry
+}
+
+// OwnerIndex returns the owner index of the client context, listing the workload controllers of the context the
+// first time it's called.
+func (c *Client) OwnerIndex(ctx context.Context) (*OwnerIndex, error) {
+	c.owners.mu.Lock()
+	if c.owners.entries == nil {
+		c.owners.entries = make(map[string]*ownerIndexEntry)
+	}
+	entry, ok := c.owners.entries[c.Context]
+	if !ok {
+		entry = &ownerIndexEntry{}
+		c.owners.entries[c.Context] = entry
+	}
+	c.owners.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.index, entry.err = c.buildOwnerIndex(ctx)
+	})
+	return entry.index, entry.err
+}
+
+func (c *Client) buildOwnerIndex(ctx context.Context) (*OwnerIndex, error) {
+	s := c.Services()
+	listers := map[string]func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error){}
+	if s.ReplicaSets != nil {
+		listers["replica sets"] = func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.ReplicaSets.List(ctx, opts)
+		}
+	}
+	if s.Deployments != nil {
+		listers["deployments"] = func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.Deployments.List(ctx, opts)
+		}
+	}
+	if s.StatefulSets != nil {
+		listers["stateful sets"] = func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.StatefulSets.List(ctx, opts)
+		}
+	}
+	if s.DaemonSets != nil {
+		listers["daemon sets"] = func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.DaemonSets.List(ctx, opts)
+		}
+	}
+	if s.Jobs != nil {
+		listers["jobs"] = func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.Jobs.List(ctx, opts)
+		}
+	}
+	if s.CronJobs != nil {
+		listers["cron jobs"] = func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.CronJobs.List(ctx, opts)
+		}
+	}
+	if s.ReplicationControllers != nil {
+		listers["replication controllers"] = func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.ReplicationControllers.List(ctx, opts)
+		}
+	}
+
+	index := &OwnerIndex{owners: make(map[types.UID][]metav1.OwnerReference)}
+	for kind, list := range listers {
+		opts := metav1.ListOptions{}
+		for {
+			result, err := list(ctx, opts)
+			if err != nil {
+				if errors.IsForbidden(err) || errors.IsNotFound(err) {
+					c.Logger().Debug("skipping owners that can't be listed", "kind", kind, "err", err)
+					break
+				}
+				return nil, err
+			}
+			if err := apimeta.EachListItem(result, func(obj runtime.Object) error {
+				m, err := apimeta.Accessor(obj)
+				if err != nil {
+					return err
+				}
+				if refs := m.GetOwnerReferences(); len(refs) > 0 {
+					index.owners[m.GetUID()] = refs
+				}
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+			l, err := apimeta.ListAccessor(result)
+			if err != nil {
+				return nil, err
+			}
+			if l.GetContinue() == "" {
+				break
+			}
+			opts.Continue = l.GetContinue()
+		}
+	}
+	return index, nil
+}
+
+// RootOwnerResolver resolves a field of the top-level owner of the object, found by following its owner references
+// through the owner index of the context.
+func RootOwnerResolver(field func(ref metav1.OwnerReference) interface{}) schema.ColumnResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		refs, _ := funk.Get(resource.Item, "OwnerReferences").([]metav1.OwnerReference)
+		if len(refs) == 0 {
+			return nil
+		}
+		index, err := meta.(*Client).OwnerIndex(ctx)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		root, ok := index.Root(refs)
+		if !ok {
+			return nil
+		}
+		return diag.WrapError(resource.Set(c.Name, field(root)))
+	}
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func ownerRef(kind, name string, uid types.UID) metav1.OwnerReference {
+	controller := true
+	return metav1.OwnerReference{Kind: kind, Name: name, UID: uid, Controller: &controller}
+}
+
+func TestOwnerIndexRoot(t *testing.T) {
+	index := &OwnerIndex{owners: map[types.UID][]metav1.OwnerReference{
+		"rs":         {ownerRef("Deployment", "web", "deploy")},
+		"rollout-rs": {ownerRef("Rollout", "canary", "rollout")},
+		"job":        {ownerRef("CronJob", "backup", "cronjob")},
+		// owner references forming a cycle must not hang the walk
+		"a": {ownerRef("Test", "b", "b")},
+		"b": {ownerRef("Test", "a", "a")},
+	}}
+
+	cases := []struct {
+		name string
+		refs []metav1.OwnerReference
+		want metav1.OwnerReference
+		ok   bool
+	}{
+		{name: "no owners"},
+		{name: "deployment pod", refs: []metav1.OwnerReference{ownerRef("ReplicaSet", "web-5d8f", "rs")}, want: ownerRef("Deployment", "web", "deploy"), ok: true},
+		{name: "rollout pod", refs: []metav1.OwnerReference{ownerRef("ReplicaSet", "canary-7c9b", "rollout-rs")}, want: ownerRef("Rollout", "canary", "rollout"), ok: true},
+		{name: "cron job pod", refs: []metav1.OwnerReference{ownerRef("Job", "backup-27700", "job")}, want: ownerRef("CronJob", "backup", "cronjob"), ok: true},
+		{name: "cycle", refs: []metav1.OwnerReference{ownerRef("Test", "a", "a")}, want: ownerRef("Test", "b", "b"), ok: true},
+		{name: "static pod", refs: []metav1.OwnerReference{ownerRef("Node", "node-1", "node")}, want: ownerRef("Node", "node-1", "node"), ok: true},
+	}
+	for _, tc := range cases {
+		got, ok := index.Root(tc.refs)
+		if ok != tc.ok || got.Kind != tc.want.Kind || got.Name != tc.want.Name || got.UID != tc.want.UID {
+			t.Errorf("%s: Root() = %v, %v, expected %v, %v", tc.name, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,459 @@
+package client
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodSecurityLevel is a level of the Pod Security Standards, see
+// https://kubernetes.io/docs/concepts/security/pod-security-standards/.
+type PodSecurityLevel string
+
+const (
+	PodSecurityPrivileged PodSecurityLevel = "privileged"
+	PodSecurityBaseline   PodSecurityLevel = "baseline"
+	PodSecurityRestricted PodSecurityLevel = "restricted"
+)
+
+// PodSecurityLatest is the version of the Pod Security Standards matching the latest Kubernetes release.
+const PodSecurityLatest = "latest"
+
+// PodSecurityEnforceVersionLabel is the namespace label pinning the version of the Pod Security Standards enforced by
+// the Pod Security admission controller.
+const PodSecurityEnforceVersionLabel = "pod-security.kubernetes.io/enforce-version"
+
+// PodSecurityViolation is a field of a pod, or of a pod template, failing a check of the Pod Security Standards.
+// Pods with baseline violations only satisfy the privileged level, pods with restricted violations only satisfy the
+// baseline level.
+type PodSecurityViolation struct {
+	Level     PodSecurityLevel
+	CheckID   string
+	FieldPath string
+	Message   string
+}
+
+type podSecurityCheck struct {
+	id    string
+	level PodSecurityLevel
+	// minVersion is the minor Kubernetes version the check applies from.
+	minVersion int
+	// windowsExempt is set for checks that don't apply to Windows pods since Kubernetes 1.25.
+	windowsExempt bool
+	check         func(minor int, meta metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation
+}
+
+var podSecurityChecks = []podSecurityCheck{
+	{id: "host_namespaces", level: PodSecurityBaseline, check: checkHostNamespaces},
+	{id: "privileged", level: PodSecurityBaseline, check: checkPrivileged},
+	{id: "capabilities_baseline", level: PodSecurityBaseline, check: checkCapabilitiesBaseline},
+	{id: "host_path_volumes", level: PodSecurityBaseline, check: checkHostPathVolumes},
+	{id: "host_ports", level: PodSecurityBaseline, check: checkHostPorts},
+	{id: "apparmor_profile", level: PodSecurityBaseline, check: checkAppArmorProfile},
+	{id: "selinux_options", level: PodSecurityBaseline, check: checkSELinuxOptions},
+	{id: "proc_mount", level: PodSecurityBaseline, check: checkProcMount},
+	{id: "seccomp_profile_baseline", level: PodSecurityBaseline, minVersion: 19, check: checkSeccompProfileBaseline},
+	{id: "sysctls", level: PodSecurityBaseline, check: checkSysctls},
+	{id: "windows_host_process", level: PodSecurityBaseline, check: checkWindowsHostProcess},
+	{id: "volume_types", level: PodSecurityRestricted, check: checkVolumeTypes},
+	{id: "allow_privilege_escalation", level: PodSecurityRestricted, minVersion: 8, windowsExempt: true, check: checkAllowPrivilegeEscalation},
+	{id: "run_as_non_root", level: PodSecurityRestricted, check: checkRunAsNonRoot},
+	{id: "run_as_user", level: PodSecurityRestricted, minVersion: 23, check: checkRunAsUser},
+	{id: "seccomp_profile_restricted", level: PodSecurityRestricted, minVersion: 19, windowsExempt: true, check: checkSeccompProfileRestricted},
+	{id: "capabilities_restricted", level: PodSecurityRestricted, minVersion: 22, windowsExempt: true, check: checkCapabilitiesRestricted},
+}
+
+// EvaluatePodSecurity evaluates the checks of the baseline and restricted levels of the given version of the Pod
+// Security Standards, e.g. v1.24 or PodSecurityLatest, against a pod or pod template. Field paths are relative to
+// the pod.
+func EvaluatePodSecurity(version string, meta metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	minor := podSecurityMinorVersion(version)
+	windows := spec.OS != nil && spec.OS.Name == corev1.Windows && minor >= 25
+	var violations []PodSecurityViolation
+	for _, c := range podSecurityChecks {
+		if minor < c.minVersion || windows && c.windowsExempt {
+			continue
+		}
+		for _, v := range c.check(minor, meta, spec) {
+			v.Level = c.level
+			v.CheckID = c.id
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
+
+// PodSecurityMaxLevel returns the most restrictive level of the Pod Security Standards satisfied by a pod with the
+// given violations.
+func PodSecurityMaxLevel(violations []PodSecurityViolation) PodSecurityLevel {
+	level := PodSecurityRestricted
+	for _, v := range violations {
+		switch v.Level {
+		case PodSecurityBaseline:
+			return PodSecurityPrivileged
+		case PodSecurityRestricted:
+			level = PodSecurityBaseline
+		}
+	}
+	return level
+}
+
+// NamespacePodSecurityVersion returns the version of the Pod Security Standards enforced in a namespace with the given
+// labels, PodSecurityLatest if the namespace doesn't pin a valid version.
+func NamespacePodSecurityVersion(labels map[string]string) string {
+	version, ok := labels[PodSecurityEnforceVersionLabel]
+	if !ok || podSecurityMinorVersion(version) == math.MaxInt32 {
+		return PodSecurityLatest
+	}
+	return version
+}
+
+// podSecurityMinorVersion parses a version of the Pod Security Standards into its minor Kubernetes version, versions
+// that can't be parsed evaluate the latest one.
+func podSecurityMinorVersion(version string) int {
+	minor, err := strconv.Atoi(strings.TrimPrefix(version, "v1."))
+	if err != nil {
+		return math.MaxInt32
+	}
+	return minor
+}
+
+// podContainer is a container of a pod with the path of its field.
+type podContainer struct {
+	path      string
+	container corev1.Container
+}
+
+func podContainers(spec corev1.PodSpec) []podContainer {
+	var containers []podContainer
+	for i, c := range spec.InitContainers {
+		containers = append(containers, podContainer{path: fmt.Sprintf("spec.initContainers[%d]", i), container: c})
+	}
+	for i, c := range spec.Containers {
+		containers = append(containers, podContainer{path: fmt.Sprintf("spec.containers[%d]", i), container: c})
+	}
+	for i, c := range spec.EphemeralContainers {
+		containers = append(containers, podContainer{path: fmt.Sprintf("spec.ephemeralContainers[%d]", i), container: corev1.Container(c.EphemeralContainerCommon)})
+	}
+	return containers
+}
+
+func violation(path, format string, args ...interface{}) PodSecurityViolation {
+	return PodSecurityViolation{FieldPath: path, Message: fmt.Sprintf(format, args...)}
+}
+
+func checkHostNamespaces(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	if spec.HostNetwork {
+		violations = append(violations, violation("spec.hostNetwork", "pod shares the host network namespace"))
+	}
+	if spec.HostPID {
+		violations = append(violations, violation("spec.hostPID", "pod shares the host process ID namespace"))
+	}
+	if spec.HostIPC {
+		violations = append(violations, violation("spec.hostIPC", "pod shares the host IPC namespace"))
+	}
+	return violations
+}
+
+func checkPrivileged(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for _, c := range podContainers(spec) {
+		if sc := c.container.SecurityContext; sc != nil && sc.Privileged != nil && *sc.Privileged {
+			violations = append(violations, violation(c.path+".securityContext.privileged", "container %s is privileged", c.container.Name))
+		}
+	}
+	return violations
+}
+
+// baselineCapabilities are the capabilities containers may add at the baseline level.
+var baselineCapabilities = map[corev1.Capability]bool{
+	"AUDIT_WRITE": true, "CHOWN": true, "DAC_OVERRIDE": true, "FOWNER": true, "FSETID": true, "KILL": true,
+	"MKNOD": true, "NET_BIND_SERVICE": true, "SETFCAP": true, "SETGID": true, "SETPCAP": true, "SETUID": true,
+	"SYS_CHROOT": true,
+}
+
+func checkCapabilitiesBaseline(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for _, c := range podContainers(spec) {
+		if sc := c.container.SecurityContext; sc != nil && sc.Capabilities != nil {
+			for i, capability := range sc.Capabilities.Add {
+				if !baselineCapabilities[capability] {
+					violations = append(violations, violation(fmt.Sprintf("%s.securityContext.capabilities.add[%d]", c.path, i),
+						"container %s adds capability %s", c.container.Name, capability))
+				}
+			}
+		}
+	}
+	return violations
+}
+
+func checkHostPathVolumes(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for i, v := range spec.Volumes {
+		if v.HostPath != nil {
+			violations = append(violations, violation(fmt.Sprintf("spec.volumes[%d].hostPath", i), "volume %s mounts host path %s", v.Name, v.HostPath.Path))
+		}
+	}
+	return violations
+}
+
+func checkHostPorts(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for _, c := range podContainers(spec) {
+		for i, p := range c.container.Ports {
+			if p.HostPort != 0 {
+				violations = append(violations, violation(fmt.Sprintf("%s.ports[%d].hostPort", c.path, i),
+					"container %s uses host port %d", c.container.Name, p.HostPort))
+			}
+		}
+	}
+	return violations
+}
+
+func checkAppArmorProfile(_ int, meta metav1.ObjectMeta, _ corev1.PodSpec) []PodSecurityViolation {
+	keys := make([]string, 0, len(meta.Annotations))
+	for k := range meta.Annotations {
+		if strings.HasPrefix(k, corev1.AppArmorBetaContainerAnnotationKeyPrefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var violations []PodSecurityViolation
+	for _, k := range keys {
+		if v := meta.Annotations[k]; v != corev1.AppArmorBetaProfileRuntimeDefault && !strings.HasPrefix(v, corev1.AppArmorBetaProfileNamePrefix) {
+			violations = append(violations, violation(fmt.Sprintf("metadata.annotations[%s]", k), "AppArmor profile %s is not allowed", meta.Annotations[k]))
+		}
+	}
+	return violations
+}
+
+// baselineSELinuxTypes are the SELinux types pods and containers may set at the baseline level.
+var baselineSELinuxTypes = map[string]bool{"": true, "container_t": true, "container_init_t": true, "container_kvm_t": true}
+
+func checkSELinuxOptions(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	check := func(path string, o *corev1.SELinuxOptions) []PodSecurityViolation {
+		var violations []PodSecurityViolation
+		if o == nil {
+			return nil
+		}
+		if !baselineSELinuxTypes[o.Type] {
+			violations = append(violations, violation(path+".type", "SELinux type %s is not allowed", o.Type))
+		}
+		if o.User != "" {
+			violations = append(violations, violation(path+".user", "SELinux user may not be set"))
+		}
+		if o.Role != "" {
+			violations = append(violations, violation(path+".role", "SELinux role may not be set"))
+		}
+		return violations
+	}
+	var violations []PodSecurityViolation
+	if spec.SecurityContext != nil {
+		violations = check("spec.securityContext.seLinuxOptions", spec.SecurityContext.SELinuxOptions)
+	}
+	for _, c := range podContainers(spec) {
+		if sc := c.container.SecurityContext; sc != nil {
+			violations = append(violations, check(c.path+".securityContext.seLinuxOptions", sc.SELinuxOptions)...)
+		}
+	}
+	return violations
+}
+
+func checkProcMount(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for _, c := range podContainers(spec) {
+		if sc := c.container.SecurityContext; sc != nil && sc.ProcMount != nil && *sc.ProcMount != corev1.DefaultProcMount {
+			violations = append(violations, violation(c.path+".securityContext.procMount", "container %s uses proc mount %s", c.container.Name, *sc.ProcMount))
+		}
+	}
+	return violations
+}
+
+func checkSeccompProfileBaseline(_ int, meta metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	if v := meta.Annotations[corev1.SeccompPodAnnotationKey]; seccompAnnotationProfileType(v) == string(corev1.SeccompProfileTypeUnconfined) {
+		violations = append(violations, violation(fmt.Sprintf("metadata.annotations[%s]", corev1.SeccompPodAnnotationKey), "pod seccomp profile is unconfined"))
+	}
+	if sc := spec.SecurityContext; sc != nil && sc.SeccompProfile != nil && sc.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+		violations = append(violations, violation("spec.securityContext.seccompProfile.type", "pod seccomp profile is unconfined"))
+	}
+	for _, c := range podContainers(spec) {
+		key := corev1.SeccompContainerAnnotationKeyPrefix + c.container.Name
+		if seccompAnnotationProfileType(meta.Annotations[key]) == string(corev1.SeccompProfileTypeUnconfined) {
+			violations = append(violations, violation(fmt.Sprintf("metadata.annotations[%s]", key), "container %s seccomp profile is unconfined", c.container.Name))
+		}
+		if sc := c.container.SecurityContext; sc != nil && sc.SeccompProfile != nil && sc.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+			violations = append(violations, violation(c.path+".securityContext.seccompProfile.type", "container %s seccomp profile is unconfined", c.container.Name))
+		}
+	}
+	return violations
+}
+
+// safeSysctls are the sysctls pods may set at the baseline level, with the minor Kubernetes version they are allowed
+// from.
+var safeSysctls = map[string]int{
+	"kernel.shm_rmid_forced":              0,
+	"net.ipv4.ip_local_port_range":        0,
+	"net.ipv4.ip_local_reserved_ports":    27,
+	"net.ipv4.ip_unprivileged_port_start": 22,
+	"net.ipv4.tcp_syncookies":             0,
+	"net.ipv4.ping_group_range":           0,
+	"net.ipv4.tcp_keepalive_time":         29,
+	"net.ipv4.tcp_fin_timeout":            29,
+	"net.ipv4.tcp_keepalive_intvl":        29,
+	"net.ipv4.tcp_keepalive_probes":       29,
+}
+
+func checkSysctls(minor int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	if spec.SecurityContext == nil {
+		return nil
+	}
+	var violations []PodSecurityViolation
+	for i, s := range spec.SecurityContext.Sysctls {
+		if since, ok := safeSysctls[s.Name]; !ok || minor < since {
+			violations = append(violations, violation(fmt.Sprintf("spec.securityContext.sysctls[%d].name", i), "sysctl %s is not safe", s.Name))
+		}
+	}
+	return violations
+}
+
+func checkWindowsHostProcess(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	hostProcess := func(o *corev1.WindowsSecurityContextOptions) bool {
+		return o != nil && o.HostProcess != nil && *o.HostProcess
+	}
+	var violations []PodSecurityViolation
+	if spec.SecurityContext != nil && hostProcess(spec.SecurityContext.WindowsOptions) {
+		violations = append(violations, violation("spec.securityContext.windowsOptions.hostProcess", "pod runs as a Windows host process"))
+	}
+	for _, c := range podContainers(spec) {
+		if sc := c.container.SecurityContext; sc != nil && hostProcess(sc.WindowsOptions) {
+			violations = append(violations, violation(c.path+".securityContext.windowsOptions.hostProcess", "container %s runs as a Windows host process", c.container.Name))
+		}
+	}
+	return violations
+}
+
+// restrictedVolumeTypes are the volume types pods may use at the restricted level, by the JSON name of their field.
+var restrictedVolumeTypes = map[string]bool{
+	"configMap": true, "csi": true, "downwardAPI": true, "emptyDir": true, "ephemeral": true,
+	"persistentVolumeClaim": true, "projected": true, "secret": true,
+}
+
+func checkVolumeTypes(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for i, v := range spec.Volumes {
+		source := reflect.ValueOf(v.VolumeSource)
+		for j := 0; j < source.NumField(); j++ {
+			if source.Field(j).IsNil() {
+				continue
+			}
+			name := strings.Split(source.Type().Field(j).Tag.Get("json"), ",")[0]
+			if !restrictedVolumeTypes[name] {
+				violations = append(violations, violation(fmt.Sprintf("spec.volumes[%d].%s", i, name), "volume %s uses restricted volume type %s", v.Name, name))
+			}
+		}
+	}
+	return violations
+}
+
+func checkAllowPrivilegeEscalation(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for _, c := range podContainers(spec) {
+		if sc := c.container.SecurityContext; sc == nil || sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			violations = append(violations, violation(c.path+".securityContext.allowPrivilegeEscalation",
+				"container %s must set allowPrivilegeEscalation to false", c.container.Name))
+		}
+	}
+	return violations
+}
+
+func checkRunAsNonRoot(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	podNonRoot := false
+	if sc := spec.SecurityContext; sc != nil && sc.RunAsNonRoot != nil {
+		if !*sc.RunAsNonRoot {
+			violations = append(violations, violation("spec.securityContext.runAsNonRoot", "pod sets runAsNonRoot to false"))
+		}
+		podNonRoot = *sc.RunAsNonRoot
+	}
+	for _, c := range podContainers(spec) {
+		sc := c.container.SecurityContext
+		switch {
+		case sc != nil && sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot:
+			violations = append(violations, violation(c.path+".securityContext.runAsNonRoot", "container %s sets runAsNonRoot to false", c.container.Name))
+		case (sc == nil || sc.RunAsNonRoot == nil) && !podNonRoot:
+			violations = append(violations, violation(c.path+".securityContext.runAsNonRoot",
+				"container %s or its pod must set runAsNonRoot to true", c.container.Name))
+		}
+	}
+	return violations
+}
+
+func checkRunAsUser(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	if sc := spec.SecurityContext; sc != nil && sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+		violations = append(violations, violation("spec.securityContext.runAsUser", "pod runs as root user"))
+	}
+	for _, c := range podContainers(spec) {
+		if sc := c.container.SecurityContext; sc != nil && sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			violations = append(violations, violation(c.path+".securityContext.runAsUser", "container %s runs as root user", c.container.Name))
+		}
+	}
+	return violations
+}
+
+func checkSeccompProfileRestricted(_ int, meta metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	allowed := func(t string) bool {
+		return t == string(corev1.SeccompProfileTypeRuntimeDefault) || t == string(corev1.SeccompProfileTypeLocalhost)
+	}
+	podProfile := seccompAnnotationProfileType(meta.Annotations[corev1.SeccompPodAnnotationKey])
+	if sc := spec.SecurityContext; sc != nil && sc.SeccompProfile != nil {
+		podProfile = string(sc.SeccompProfile.Type)
+	}
+	var violations []PodSecurityViolation
+	for _, c := range podContainers(spec) {
+		profile := seccompAnnotationProfileType(meta.Annotations[corev1.SeccompContainerAnnotationKeyPrefix+c.container.Name])
+		if sc := c.container.SecurityContext; sc != nil && sc.SeccompProfile != nil {
+			profile = string(sc.SeccompProfile.Type)
+		}
+		if profile == "" {
+			profile = podProfile
+		}
+		if !allowed(profile) {
+			violations = append(violations, violation(c.path+".securityContext.seccompProfile.type",
+				"container %s or its pod must set seccomp profile RuntimeDefault or Localhost", c.container.Name))
+		}
+	}
+	return violations
+}
+
+func checkCapabilitiesRestricted(_ int, _ metav1.ObjectMeta, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	for _, c := range podContainers(spec) {
+		var capabilities corev1.Capabilities
+		if sc := c.container.SecurityContext; sc != nil && sc.Capabilities != nil {
+			capabilities = *sc.Capabilities
+		}
+		dropsAll := false
+		for _, capability := range capabilities.Drop {
+			dropsAll = dropsAll || capability == "ALL"
+		}
+		if !dropsAll {
+			violations = append(violations, violation(c.path+".securityContext.capabilities.drop", "container %s must drop ALL capabilities", c.container.Name))
+		}
+		for i, capability := range capabilities.Add {
+			if capability != "NET_BIND_SERVICE" {
+				violations = append(violations, violation(fmt.Sprintf("%s.securityContext.capabilities.add[%d]", c.path, i),
+					"container %s may only add capability NET_BIND_SERVICE, adds %s", c.container.Name, capability))
+			}
+		}
+	}
+	return violations
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func restrictedPodSpec() corev1.PodSpec {
+	nonRoot, escalation := true, false
+	return corev1.PodSpec{
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot:   &nonRoot,
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		Containers: []corev1.Container{{
+			Name: "app",
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: &escalation,
+				Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}, Add: []corev1.Capability{"NET_BIND_SERVICE"}},
+			},
+		}},
+		Volumes: []corev1.Volume{{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+	}
+}
+
+func TestEvaluatePodSecurity(t *testing.T) {
+	privileged := true
+	var rootUser int64
+
+	cases := []struct {
+		name    string
+		version string
+		meta    metav1.ObjectMeta
+		spec    func(spec *corev1.PodSpec)
+		want    map[string]string
+		level   PodSecurityLevel
+	}{
+		{name: "restricted", spec: func(spec *corev1.PodSpec) {}, level: PodSecurityRestricted},
+		{
+			name: "host namespaces and privileged",
+			spec: func(spec *corev1.PodSpec) {
+				spec.HostNetwork = true
+				spec.Containers[0].SecurityContext.Privileged = &privileged
+			},
+			want: map[string]string{
+				"spec.hostNetwork": "host_namespaces",
+				"spec.containers[0].securityContext.privileged": "privileged",
+			},
+			level: PodSecurityPrivileged,
+		},
+		{
+			name: "restricted volume and capabilities",
+			spec: func(spec *corev1.PodSpec) {
+				spec.Volumes = append(spec.Volumes, corev1.Volume{Name: "nfs", VolumeSource: corev1.VolumeSource{NFS: &corev1.NFSVolumeSource{}}})
+				spec.Containers[0].SecurityContext.Capabilities = nil
+				spec.Containers[0].SecurityContext.RunAsUser = &rootUser
+			},
+			want: map[string]string{
+				"spec.volumes[1].nfs": "volume_types",
+				"spec.containers[0].securityContext.capabilities.drop": "capabilities_restricted",
+				"spec.containers[0].securityContext.runAsUser":         "run_as_user",
+			},
+			level: PodSecurityBaseline,
+		},
+		{
+			name:    "checks of later versions",
+			version: "v1.21",
+			spec: func(spec *corev1.PodSpec) {
+				spec.Containers[0].SecurityContext.Capabilities = nil
+				spec.Containers[0].SecurityContext.RunAsUser = &rootUser
+			},
+			level: PodSecurityRestricted,
+		},
+		{
+			name:    "sysctls of later versions",
+			version: "v1.26",
+			spec: func(spec *corev1.PodSpec) {
+				spec.SecurityContext.Sysctls = []corev1.Sysctl{
+					{Name: "net.ipv4.ip_unprivileged_port_start", Value: "0"},
+					{Name: "net.ipv4.ip_local_reserved_ports", Value: "8080"},
+					{Name: "net.ipv4.tcp_keepalive_time", Value: "600"},
+				}
+			},
+			want: map[string]string{
+				"spec.securityContext.sysctls[1].name": "sysctls",
+				"spec.securityContext.sysctls[2].name": "sysctls",
+			},
+			level: PodSecurityPrivileged,
+		},
+		{
+			name: "sysctls of the latest version",
+			spec: func(spec *corev1.PodSpec) {
+				spec.SecurityContext.Sysctls = []corev1.Sysctl{
+					{Name: "net.ipv4.ip_local_reserved_ports", Value: "8080"},
+					{Name: "net.ipv4.tcp_keepalive_probes", Value: "9"},
+				}
+			},
+			level: PodSecurityRestricted,
+		},
+		{
+			name: "unconfined seccomp annotation and sysctl",
+			meta: metav1.ObjectMeta{Annotations: map[string]string{corev1.SeccompContainerAnnotationKeyPrefix + "app": corev1.SeccompProfileNameUnconfined}},
+			spec: func(spec *corev1.PodSpec) {
+				spec.SecurityContext.SeccompProfile = nil
+				spec.SecurityContext.Sysctls = []corev1.Sysctl{{Name: "kernel.msgmax", Value: "65536"}}
+			},
+			want: map[string]string{
+				"metadata.annotations[container.seccomp.security.alpha.kubernetes.io/app]": "seccomp_profile_baseline",
+				"spec.securityContext.sysctls[0].name":                                     "sysctls",
+				"spec.containers[0].securityContext.seccompProfile.type":                   "seccomp_profile_restricted",
+			},
+			level: PodSecurityPrivileged,
+		},
+	}
+	for _, tc := range cases {
+		spec := restrictedPodSpec()
+		tc.spec(&spec)
+		version := tc.version
+		if version == "" {
+			version = PodSecurityLatest
+		}
+		violations := EvaluatePodSecurity(version, tc.meta, spec)
+		got := make(map[string]string)
+		for _, v := range violations {
+			got[v.FieldPath] = v.CheckID
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: expected violations %v, got %+v", tc.name, tc.want, violations)
+		}
+		for path, id := range tc.want {
+			if got[path] != id {
+				t.Errorf("%s: expected %s violation at %s, got %+v", tc.name, id, path, violations)
+			}
+		}
+		if level := PodSecurityMaxLevel(violations); level != tc.level {
+			t.Errorf("%s: PodSecurityMaxLevel() = %s, expected %s", tc.name, level, tc.level)
+		}
+	}
+}
+
+func TestNamespacePodSecurityVersion(t *testing.T) {
+	cases := []struct {
+		labels map[string]string
+		want   string
+	}{
+		{want: PodSecurityLatest},
+		{labels: map[string]string{PodSecurityEnforceVersionLabel: "v1.24"}, want: "v1.24"},
+		{labels: map[string]string{PodSecurityEnforceVersionLabel: PodSecurityLatest}, want: PodSecurityLatest},
+		{labels: map[string]string{PodSecurityEnforceVersionLabel: "1.24"}, want: PodSecurityLatest},
+		{labels: map[string]string{"pod-security.kubernetes.io/enforce": "baseline"}, want: PodSecurityLatest},
+	}
+	for _, tc := range cases {
+		if got := NamespacePodSecurityVersion(tc.labels); got != tc.want {
+			t.Errorf("NamespacePodSecurityVersion(%v) = %s, expected %s", tc.labels, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type podSecurityVersionsEntry struct {
+	once     sync.Once
+	versions map[string]string
+	err      error
+}
+
+// podSecurityVersions holds the Pod Security Standards versions of the namespaces of each context, listed once on
+// first use.
+type podSecurityVersions struct {
+	mu      sync.Mutex
+	entries map[string]*podSecurityVersionsEntry
+}
+
+// PodSecurityVersion returns the version of the Pod Security Standards enforced in a namespace of the client context,
+// listing the namespaces of the context the first time it's called. Namespaces that can't be listed enforce
+// PodSecurityLatest.
+func (c *Client) PodSecurityVersion(ctx context.Context, namespace string) (string, error) {
+	c.pssVersions.mu.Lock()
+	if c.pssVersions.entries == nil {
+		c.pssVersions.entries = make(map[string]*podSecurityVersionsEntry)
+	}
+	entry, ok := c.pssVersions.entries[c.Context]
+	if !ok {
+		entry = &podSecurityVersionsEntry{}
+		c.pssVersions.entries[c.Context] = entry
+	}
+	c.pssVersions.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.versions, entry.err = c.listPodSecurityVersions(ctx)
+	})
+	if entry.err != nil {
+		return "", entry.err
+	}
+	if version, ok := entry.versions[namespace]; ok {
+		return version, nil
+	}
+	return PodSecurityLatest, nil
+}
+
+func (c *Client) listPodSecurityVersions(ctx context.Context) (map[string]string, error) {
+	versions := make(map[string]string)
+	namespaces := c.Services().Namespaces
+	if namespaces == nil {
+		return versions, nil
+	}
+	opts := metav1.ListOptions{}
+	for {
+		result, err := namespaces.List(ctx, opts)
+		if err != nil {
+			if errors.IsForbidden(err) || errors.IsNotFound(err) {
+				c.Logger().Debug("skipping pod security versions of namespaces that can't be listed", "err", err)
+				return versions, nil
+			}
+			return nil, err
+		}
+		for _, ns := range result.Items {
+			versions[ns.Name] = NamespacePodSecurityVersion(ns.Labels)
+		}
+		if result.GetContinue() == "" {
+			return versions, nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SecurityContext holds the security settings a container effectively runs with. Container-level settings take
+// precedence over pod-level ones and seccomp and AppArmor profiles that are set through the legacy annotations are
+// taken into account. Settings that are left to the container image or runtime are nil or empty.
+type SecurityContext struct {
+	Privileged               bool
+	AllowPrivilegeEscalation bool
+	RunAsNonRoot             bool
+	RunAsUser                *int64
+	RunAsGroup               *int64
+	ReadOnlyRootFilesystem   bool
+	CapabilitiesAdd          []string
+	CapabilitiesDrop         []string
+	SeccompProfileType       string
+	AppArmorProfile          string
+	SELinuxOptions           *corev1.SELinuxOptions
+}
+
+// PodSecurityContext returns the security settings set at the pod level, which apply to all containers that don't
+// override them.
+func PodSecurityContext(pod corev1.Pod) SecurityContext {
+	var s SecurityContext
+	if psc := pod.Spec.SecurityContext; psc != nil {
+		if psc.RunAsNonRoot != nil {
+			s.RunAsNonRoot = *psc.RunAsNonRoot
+		}
+		s.RunAsUser = psc.RunAsUser
+		s.RunAsGroup = psc.RunAsGroup
+		s.SELinuxOptions = psc.SELinuxOptions
+		if psc.SeccompProfile != nil {
+			s.SeccompProfileType = string(psc.SeccompProfile.Type)
+		}
+	}
+	if s.SeccompProfileType == "" {
+		s.SeccompProfileType = seccompAnnotationProfileType(pod.Annotations[corev1.SeccompPodAnnotationKey])
+	}
+	return s
+}
+
+// EffectiveSecurityContext merges the security context of the named container with the one of its pod.
+func EffectiveSecurityContext(pod corev1.Pod, container string, sc *corev1.SecurityContext) SecurityContext {
+	s := PodSecurityContext(pod)
+	s.AllowPrivilegeEscalation = true
+	if seccomp := seccompAnnotationProfileType(pod.Annotations[corev1.SeccompContainerAnnotationKeyPrefix+container]); seccomp != "" {
+		s.SeccompProfileType = seccomp
+	}
+	s.AppArmorProfile = pod.Annotations[corev1.AppArmorBetaContainerAnnotationKeyPrefix+container]
+	if sc == nil {
+		return s
+	}
+	if sc.Privileged != nil {
+		s.Privileged = *sc.Privileged
+	}
+	if sc.RunAsNonRoot != nil {
+		s.RunAsNonRoot = *sc.RunAsNonRoot
+	}
+	if sc.RunAsUser != nil {
+		s.RunAsUser = sc.RunAsUser
+	}
+	if sc.RunAsGroup != nil {
+		s.RunAsGroup = sc.RunAsGroup
+	}
+	if sc.ReadOnlyRootFilesystem != nil {
+		s.ReadOnlyRootFilesystem = *sc.ReadOnlyRootFilesystem
+	}
+	if sc.Capabilities != nil {
+		for _, c := range sc.Capabilities.Add {
+			s.CapabilitiesAdd = append(s.CapabilitiesAdd, string(c))
+		}
+		for _, c := range sc.Capabilities.Drop {
+			s.CapabilitiesDrop = append(s.CapabilitiesDrop, string(c))
+		}
+	}
+	if sc.SeccompProfile != nil {
+		s.SeccompProfileType = string(sc.SeccompProfile.Type)
+	}
+	if sc.SELinuxOptions != nil {
+		s.SELinuxOptions = sc.SELinuxOptions
+	}
+	// Privilege escalation is always allowed for privileged containers and containers with CAP_SYS_ADMIN,
+	// see https://kubernetes.io/docs/tasks/configure-pod-container/security-context/.
+	switch {
+	case s.Privileged, hasCapability(s.CapabilitiesAdd, "SYS_ADMIN"):
+		s.AllowPrivilegeEscalation = true
+	case sc.AllowPrivilegeEscalation != nil:
+		s.AllowPrivilegeEscalation = *sc.AllowPrivilegeEscalation
+	}
+	return s
+}
+
+// seccompAnnotationProfileType maps the value of a legacy seccomp annotation to the matching profile type.
+func seccompAnnotationProfileType(v string) string {
+	switch {
+	case v == "":
+		return ""
+	case v == corev1.SeccompProfileRuntimeDefault, v == corev1.DeprecatedSeccompProfileDockerDefault:
+		return string(corev1.SeccompProfileTypeRuntimeDefault)
+	case v == corev1.SeccompProfileNameUnconfined:
+		return string(corev1.SeccompProfileTypeUnconfined)
+	case strings.HasPrefix(v, corev1.SeccompLocalhostProfileNamePrefix):
+		return string(corev1.SeccompProfileTypeLocalhost)
+	}
+	return ""
+}
+
+func hasCapability(caps []string, name string) bool {
+	for _, c := range caps {
+		if strings.TrimPrefix(strings.ToUpper(c), "CAP_") == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEffectiveSecurityContext(t *testing.T) {
+	yes, no := true, false
+	uid, podUID, gid := int64(1000), int64(2000), int64(3000)
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			corev1.SeccompPodAnnotationKey:                           corev1.SeccompProfileRuntimeDefault,
+			corev1.SeccompContainerAnnotationKeyPrefix + "sidecar":   "localhost/profile.json",
+			corev1.AppArmorBetaContainerAnnotationKeyPrefix + "app": corev1.AppArmorBetaProfileRuntimeDefault,
+		}},
+		Spec: corev1.PodSpec{SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot:   &yes,
+			RunAsUser:      &podUID,
+			RunAsGroup:     &gid,
+			SELinuxOptions: &corev1.SELinuxOptions{Level: "s0:c123,c456"},
+		}},
+	}
+
+	cases := []struct {
+		name      string
+		container string
+		sc        *corev1.SecurityContext
+		want      SecurityContext
+	}{
+		{
+			name:      "inherits pod settings",
+			container: "app",
+			want: SecurityContext{
+				AllowPrivilegeEscalation: true,
+				RunAsNonRoot:             true,
+				RunAsUser:                &podUID,
+				RunAsGroup:               &gid,
+				SeccompProfileType:       "RuntimeDefault",
+				AppArmorProfile:          "runtime/default",
+				SELinuxOptions:           &corev1.SELinuxOptions{Level: "s0:c123,c456"},
+			},
+		},
+		{
+			name:      "container overrides pod",
+			container: "sidecar",
+			sc: &corev1.SecurityContext{
+				RunAsNonRoot:             &no,
+				RunAsUser:                &uid,
+				AllowPrivilegeEscalation: &no,
+				ReadOnlyRootFilesystem:   &yes,
+				Capabilities:             &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}, Drop: []corev1.Capability{"ALL"}},
+			},
+			want: SecurityContext{
+				RunAsUser:              &uid,
+				RunAsGroup:             &gid,
+				ReadOnlyRootFilesystem: true,
+				CapabilitiesAdd:        []string{"NET_BIND_SERVICE"},
+				CapabilitiesDrop:       []string{"ALL"},
+				SeccompProfileType:     "Localhost",
+				SELinuxOptions:         &corev1.SELinuxOptions{Level: "s0:c123,c456"},
+			},
+		},
+		{
+			name:      "privileged containers can always escalate",
+			container: "debug",
+			sc: &corev1.SecurityContext{
+				Privileged:               &yes,
+				AllowPrivilegeEscalation: &no,
+				SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+			},
+			want: SecurityContext{
+				Privileged:               true,
+				AllowPrivilegeEscalation: true,
+				RunAsNonRoot:             true,
+				RunAsUser:                &podUID,
+				RunAsGroup:               &gid,
+				SeccompProfileType:       "Unconfined",
+				SELinuxOptions:           &corev1.SELinuxOptions{Level: "s0:c123,c456"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		if got := EffectiveSecurityContext(pod, tc.container, tc.sc); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: EffectiveSecurityContext() = %+v, expected %+v", tc.name, got, tc.want)
+		}
+	}
+}
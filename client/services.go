@@ -3,35 +3,94 @@ package client
 import (
 	"context"
 
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	eventsv1 "k8s.io/api/events/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/kubernetes"
 )
 
 type Services struct {
 	Client *kubernetes.Clientset
 
-	CronJobs        CronJobsClient
-	DaemonSets      DaemonSetsClient
-	Deployments     DeploymentsClient
-	Endpoints       EndpointsClient
-	Jobs            JobsClient
-	LimitRanges     LimitRangesClient
-	Namespaces      NamespacesClient
-	NetworkPolicies NetworkPoliciesClient
-	Nodes           NodesClient
-	Pods            PodsClient
-	ReplicaSets     ReplicaSetsClient
-	ResourceQuotas  ResourceQuotasClient
-	RoleBindings    RoleBindingsClient
-	Roles           RolesClient
-	ServiceAccounts ServiceAccountsClient
-	Services        ServicesClient
-	StatefulSets    StatefulSetsClient
+	APIServices                       APIServicesClient
+	CertificateSigningRequests        CertificateSigningRequestsClient
+	ClusterRoleBindings               ClusterRoleBindingsClient
+	ClusterRoles                      ClusterRolesClient
+	ControllerRevisions               ControllerRevisionsClient
+	CronJobs                          CronJobsClient
+	DaemonSets                        DaemonSetsClient
+	Deployments                       DeploymentsClient
+	Discovery                         DiscoveryClient
+	EndpointSlices                    EndpointSlicesClient
+	Endpoints                         EndpointsClient
+	Events                            EventsClient
+	EventsV1                          EventsV1Client
+	HorizontalPodAutoscalers          HorizontalPodAutoscalersClient
+	HorizontalPodAutoscalersV1        HorizontalPodAutoscalersV1Client
+	Jobs                              JobsClient
+	Leases                            LeasesClient
+	LimitRanges                       LimitRangesClient
+	MutatingWebhookConfigurations     MutatingWebhookConfigurationsClient
+	Namespaces                        NamespacesClient
+	NetworkPolicies                   NetworkPoliciesClient
+	NodeMetrics                       NodeMetricsClient
+	Nodes                             NodesClient
+	PodDisruptionBudgets              PodDisruptionBudgetsClient
+	PodMetrics                        PodMetricsClient
+	PodTemplates                      PodTemplatesClient
+	Pods                              PodsClient
+	PriorityClasses                   PriorityClassesClient
+	ReplicaSets                       ReplicaSetsClient
+	ReplicationControllers            ReplicationControllersClient
+	ResourceQuotas                    ResourceQuotasClient
+	RoleBindings                      RoleBindingsClient
+	Roles                             RolesClient
+	RuntimeClasses                    RuntimeClassesClient
+	ServiceAccounts                   ServiceAccountsClient
+	Services                          ServicesClient
+	StatefulSets                      StatefulSetsClient
+	ValidatingAdmissionPolicies       ValidatingAdmissionPoliciesClient
+	ValidatingAdmissionPolicyBindings ValidatingAdmissionPolicyBindingsClient
+	ValidatingWebhookConfigurations   ValidatingWebhookConfigurationsClient
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/api_services.go . APIServicesClient
+type APIServicesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*APIServiceList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/certificate_signing_requests.go . CertificateSigningRequestsClient
+type CertificateSigningRequestsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*certificatesv1.CertificateSigningRequestList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/cluster_role_bindings.go . ClusterRoleBindingsClient
+type ClusterRoleBindingsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*rbacv1.ClusterRoleBindingList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/cluster_roles.go . ClusterRolesClient
+type ClusterRolesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*rbacv1.ClusterRoleList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/controller_revisions.go . ControllerRevisionsClient
+type ControllerRevisionsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*appsv1.ControllerRevisionList, error)
 }
 
 //go:generate mockgen -package=mocks -destination=./mocks/cronjobs.go . CronJobsClient
@@ -49,21 +108,63 @@ type DeploymentsClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*appsv1.DeploymentList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/discovery.go . DiscoveryClient
+type DiscoveryClient interface {
+	ServerGroups() (*metav1.APIGroupList, error)
+	ServerGroupsAndResources() ([]*metav1.APIGroup, []*metav1.APIResourceList, error)
+	ServerVersion() (*version.Info, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/endpoint_slices.go . EndpointSlicesClient
+type EndpointSlicesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*discoveryv1.EndpointSliceList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/endpoints.go . EndpointsClient
 type EndpointsClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*corev1.EndpointsList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/events.go . EventsClient
+type EventsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*corev1.EventList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/events_v1.go . EventsV1Client
+type EventsV1Client interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*eventsv1.EventList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/horizontal_pod_autoscalers.go . HorizontalPodAutoscalersClient
+type HorizontalPodAutoscalersClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*autoscalingv2.HorizontalPodAutoscalerList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/horizontal_pod_autoscalers_v1.go . HorizontalPodAutoscalersV1Client
+type HorizontalPodAutoscalersV1Client interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*autoscalingv1.HorizontalPodAutoscalerList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/jobs.go . JobsClient
 type JobsClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*batchv1.JobList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/leases.go . LeasesClient
+type LeasesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*coordinationv1.LeaseList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/limit_ranges.go . LimitRangesClient
 type LimitRangesClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*corev1.LimitRangeList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/mutating_webhook_configurations.go . MutatingWebhookConfigurationsClient
+type MutatingWebhookConfigurationsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.MutatingWebhookConfigurationList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/namespaces.go . NamespacesClient
 type NamespacesClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error)
@@ -74,21 +175,51 @@ type NetworkPoliciesClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*networkingv1.NetworkPolicyList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/node_metrics.go . NodeMetricsClient
+type NodeMetricsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*NodeMetricsList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/nodes.go . NodesClient
 type NodesClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*corev1.NodeList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/pod_disruption_budgets.go . PodDisruptionBudgetsClient
+type PodDisruptionBudgetsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*policyv1.PodDisruptionBudgetList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/pod_metrics.go . PodMetricsClient
+type PodMetricsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*PodMetricsList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/pod_templates.go . PodTemplatesClient
+type PodTemplatesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*corev1.PodTemplateList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/pods.go . PodsClient
 type PodsClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/priority_classes.go . PriorityClassesClient
+type PriorityClassesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*schedulingv1.PriorityClassList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/replica_sets.go . ReplicaSetsClient
 type ReplicaSetsClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*appsv1.ReplicaSetList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/replication_controllers.go . ReplicationControllersClient
+type ReplicationControllersClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*corev1.ReplicationControllerList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/resource_quotas.go . ResourceQuotasClient
 type ResourceQuotasClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*corev1.ResourceQuotaList, error)
@@ -104,6 +235,11 @@ type RolesClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*rbacv1.RoleList, error)
 }
 
+//go:generate mockgen -package=mocks -destination=./mocks/runtime_classes.go . RuntimeClassesClient
+type RuntimeClassesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*nodev1.RuntimeClassList, error)
+}
+
 //go:generate mockgen -package=mocks -destination=./mocks/service_accounts.go . ServiceAccountsClient
 type ServiceAccountsClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*corev1.ServiceAccountList, error)
@@ -118,3 +254,18 @@ type ServicesClient interface {
 type StatefulSetsClient interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*appsv1.StatefulSetList, error)
 }
+
+//go:generate mockgen -package=mocks -destination=./mocks/validating_admission_policies.go . ValidatingAdmissionPoliciesClient
+type ValidatingAdmissionPoliciesClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*ValidatingAdmissionPolicyList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/validating_admission_policy_bindings.go . ValidatingAdmissionPolicyBindingsClient
+type ValidatingAdmissionPolicyBindingsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*ValidatingAdmissionPolicyBindingList, error)
+}
+
+//go:generate mockgen -package=mocks -destination=./mocks/validating_webhook_configurations.go . ValidatingWebhookConfigurationsClient
+type ValidatingWebhookConfigurationsClient interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.ValidatingWebhookConfigurationList, error)
+}
@@ -9,10 +9,13 @@ import (
 	providertest "github.com/cloudquery/cq-provider-sdk/provider/testing"
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/go-hclog"
+	"k8s.io/client-go/tools/clientcmd/api"
 )
 
 type TestOptions struct {
 	SkipEmptyJsonB bool
+	// KubeConfig is the kube configuration the mocked client is created with.
+	KubeConfig api.Config
 }
 
 func K8sTestHelper(t *testing.T, table *schema.Table, snapshotDirPath string) {
@@ -46,6 +49,7 @@ func K8sMockTestHelper(t *testing.T, table *schema.Table, builder func(*testing.
 			Configure: func(logger hclog.Logger, _ interface{}) (schema.ClientMeta, diag.Diagnostics) {
 				c := &Client{
 					Log:     logger,
+					kConfig: options.KubeConfig,
 					Context: "testContext",
 				}
 				c.SetServices(map[string]Services{"testContext": builder(t, ctrl)})
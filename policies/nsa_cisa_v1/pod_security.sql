@@ -26,6 +26,10 @@
 \set check_id "replicaset_container_privilege_disabled"
 \ir ../queries/pod_security/replicaset_container_privilege_disabled.sql
 
+\echo "StatefulSet container privileged access disabled"
+\set check_id "statefulset_container_privilege_disabled"
+\ir ../queries/pod_security/statefulset_container_privilege_disabled.sql
+
 \echo "Container privileged escalation disabled"
 
 \echo "DaemonSet container privileged escalation disabled"
@@ -48,6 +52,10 @@
 \set check_id "replicaset_container_privilege_escalation_disabled"
 \ir ../queries/pod_security/replicaset_container_privilege_escalation_disabled.sql
 
+\echo "StatefulSet container privileged escalation disabled"
+\set check_id "statefulset_container_privilege_escalation_disabled"
+\ir ../queries/pod_security/statefulset_container_privilege_escalation_disabled.sql
+
 
 \echo "Host network access disabled"
 
@@ -116,6 +124,10 @@
 \set check_id "replicaset_immutable_container_filesystem"
 \ir ../queries/pod_security/replicaset_immutable_container_filesystem.sql
 
+\echo "StatefulSet containers root file system is read-only"
+\set check_id "statefulset_immutable_container_filesystem"
+\ir ../queries/pod_security/statefulset_immutable_container_filesystem.sql
+
 
 \echo "Enforce containers to run as non-root"
 
@@ -139,6 +151,10 @@
 \set check_id "replicaset_non_root_container"
 \ir ../queries/pod_security/replicaset_non_root_container.sql
 
+\echo "StatefulSet containers to run as non-root"
+\set check_id "statefulset_non_root_container"
+\ir ../queries/pod_security/statefulset_non_root_container.sql
+
 
 \echo "Automatic mapping of the service account tokens disabled"
 
@@ -150,3 +166,13 @@
 \set check_id "service_account_token_disabled"
 \ir ../queries/pod_security/service_account_token_disabled.sql
 
+
+\echo "Pod Security Standards"
+
+\echo "Pods satisfy the baseline Pod Security Standard"
+\set check_id "pod_security_standards_baseline"
+\ir ../queries/pod_security/pod_security_standards_baseline.sql
+
+\echo "Pods satisfy the restricted Pod Security Standard"
+\set check_id "pod_security_standards_restricted"
+\ir ../queries/pod_security/pod_security_standards_restricted.sql
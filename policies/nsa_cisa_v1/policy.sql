@@ -12,4 +12,5 @@ END AS "execution_time"  \gset
 
 \ir ../create_k8s_policy_results.sql
 \ir ./network_hardening.sql
+\ir ../../views/workload_containers.sql
 \ir ./pod_security.sql
@@ -1,28 +1,23 @@
+WITH context_pods AS (SELECT context, COUNT(*) AS pods
+                      FROM k8s_networking_pod_isolation
+                      GROUP BY context)
+
 INSERT
 INTO k8s_policy_results (resource_id, execution_time, framework, check_id, title, context, namespace,
                         resource_name, status)
-select distinct uid                                          AS resource_id,
-                :'execution_time'::timestamp                 AS execution_time,
-                :'framework'                                 AS framework,
-                :'check_id'                                  AS check_id,
-                'Network policy default don''t allow egress' AS title,
-                context                                      AS context,
-                namespace                                    AS namespace,
-                name                                         AS resource_name,
-                CASE
-                    WHEN
-                            k8s_networking_network_policy_ingress_ports.network_policy_ingress_cq_id IS NULL
-                            OR k8s_networking_network_policy_ingress_from.network_policy_ingress_cq_id IS NULL
-                        THEN 'fail'
-                    ELSE 'pass'
-                    END                                      AS status
-FROM k8s_networking_network_policies
-         LEFT JOIN k8s_networking_network_policy_ingress
-                   ON k8s_networking_network_policy_ingress.network_policy_cq_id =
-                      k8s_networking_network_policies.cq_id
-         LEFT JOIN k8s_networking_network_policy_ingress_ports
-                   ON k8s_networking_network_policy_ingress_ports.network_policy_ingress_cq_id =
-                      k8s_networking_network_policy_ingress.network_policy_uid::UUID
-         LEFT JOIN k8s_networking_network_policy_ingress_from
-                   ON k8s_networking_network_policy_ingress_from.network_policy_ingress_cq_id =
-                      k8s_networking_network_policy_ingress.network_policy_uid::UUID
\ No newline at end of file
+select pod_uid                                       AS resource_id,
+       :'execution_time'::timestamp                  AS execution_time,
+       :'framework'                                  AS framework,
+       :'check_id'                                   AS check_id,
+       'Network policy default don''t allow ingress' AS title,
+       context                                       AS context,
+       namespace                                     AS namespace,
+       pod_name                                      AS resource_name,
+       CASE
+           WHEN
+               NOT ingress_isolated OR ingress_allowed_pods = pods
+               THEN 'fail'
+           ELSE 'pass'
+           END                                       AS status
+FROM k8s_networking_pod_isolation
+         JOIN context_pods USING (context)
@@ -1,18 +1,19 @@
 INSERT INTO k8s_policy_results (resource_id, execution_time, framework, check_id, title, context, namespace,
                                resource_name, status)
-select uid                             AS resource_id,
+select workload_uid                    AS resource_id,
        :'execution_time'::timestamp    AS execution_time,
        :'framework'                    AS framework,
        :'check_id'                     AS check_id,
        'Deamonset privileges disabled' AS title,
        context                         AS context,
        namespace                       AS namespace,
-       name                            AS resource_name,
+       workload_name                   AS resource_name,
        CASE
            WHEN
-               c -> 'securityContext' ->> 'privileged' = 'true'
+               privileged IS TRUE
                THEN 'fail'
            ELSE 'pass'
            END                         AS status
-FROM k8s_apps_daemon_sets,
-     JSONB_ARRAY_ELEMENTS(template -> 'spec' -> 'containers') AS c
\ No newline at end of file
+FROM k8s_workload_containers
+WHERE workload_kind = 'DaemonSet'
+  AND container_type = 'container';
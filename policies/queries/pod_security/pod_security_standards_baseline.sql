@@ -0,0 +1,17 @@
+INSERT INTO k8s_policy_results (resource_id, execution_time, framework, check_id, title, context, namespace,
+                               resource_name, status)
+select uid                                                AS resource_id,
+       :'execution_time'::timestamp                       AS execution_time,
+       :'framework'                                       AS framework,
+       :'check_id'                                        AS check_id,
+       'Pod satisfies the baseline Pod Security Standard' AS title,
+       context                                            AS context,
+       namespace                                          AS namespace,
+       name                                               AS resource_name,
+       CASE
+           WHEN
+               pss_max_level = 'privileged'
+               THEN 'fail'
+           ELSE 'pass'
+           END                                            AS status
+FROM k8s_core_pods
@@ -0,0 +1,19 @@
+INSERT INTO k8s_policy_results (resource_id, execution_time, framework, check_id, title, context, namespace,
+                               resource_name, status)
+select workload_uid                                           AS resource_id,
+       :'execution_time'::timestamp                           AS execution_time,
+       :'framework'                                           AS framework,
+       :'check_id'                                            AS check_id,
+       'StatefulSet container privileged escalation disabled' AS title,
+       context                                                AS context,
+       namespace                                              AS namespace,
+       workload_name                                          AS resource_name,
+       CASE
+           WHEN
+               allow_privilege_escalation IS DISTINCT FROM false
+               THEN 'fail'
+           ELSE 'pass'
+           END                                                AS status
+FROM k8s_workload_containers
+WHERE workload_kind = 'StatefulSet'
+  AND container_type = 'container';
@@ -0,0 +1,29 @@
+resource "kubernetes_mutating_webhook_configuration_v1" "example" {
+  metadata {
+    name = "mutating-webhook${var.test_prefix}${var.test_suffix}"
+  }
+
+  webhook {
+    name                      = "mutating.example.com"
+    admission_review_versions = ["v1"]
+    side_effects              = "None"
+    failure_policy            = "Ignore"
+    timeout_seconds           = 5
+    reinvocation_policy       = "IfNeeded"
+
+    client_config {
+      service {
+        namespace = "default"
+        name      = "example-webhook"
+      }
+    }
+
+    rule {
+      api_groups   = ["apps"]
+      api_versions = ["v1"]
+      operations   = ["CREATE"]
+      resources    = ["deployments"]
+      scope        = "Namespaced"
+    }
+  }
+}
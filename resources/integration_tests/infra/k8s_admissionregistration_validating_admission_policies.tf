@@ -0,0 +1,38 @@
+resource "kubernetes_manifest" "validating_admission_policy" {
+  manifest = {
+    apiVersion = "admissionregistration.k8s.io/v1"
+    kind       = "ValidatingAdmissionPolicy"
+    metadata = {
+      name = "replica-limit${var.test_prefix}${var.test_suffix}"
+    }
+    spec = {
+      failurePolicy = "Fail"
+      matchConstraints = {
+        resourceRules = [{
+          apiGroups   = ["apps"]
+          apiVersions = ["v1"]
+          operations  = ["CREATE", "UPDATE"]
+          resources   = ["deployments"]
+        }]
+      }
+      validations = [{
+        expression = "object.spec.replicas <= 5"
+        message    = "replicas must be no greater than 5"
+      }]
+    }
+  }
+}
+
+resource "kubernetes_manifest" "validating_admission_policy_binding" {
+  manifest = {
+    apiVersion = "admissionregistration.k8s.io/v1"
+    kind       = "ValidatingAdmissionPolicyBinding"
+    metadata = {
+      name = "replica-limit${var.test_prefix}${var.test_suffix}"
+    }
+    spec = {
+      policyName        = kubernetes_manifest.validating_admission_policy.manifest.metadata.name
+      validationActions = ["Warn", "Audit"]
+    }
+  }
+}
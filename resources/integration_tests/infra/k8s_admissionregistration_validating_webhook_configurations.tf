@@ -0,0 +1,28 @@
+resource "kubernetes_validating_webhook_configuration_v1" "example" {
+  metadata {
+    name = "validating-webhook${var.test_prefix}${var.test_suffix}"
+  }
+
+  webhook {
+    name                      = "validating.example.com"
+    admission_review_versions = ["v1"]
+    side_effects              = "None"
+    failure_policy            = "Ignore"
+    timeout_seconds           = 5
+
+    client_config {
+      service {
+        namespace = "default"
+        name      = "example-webhook"
+      }
+    }
+
+    rule {
+      api_groups   = ["apps"]
+      api_versions = ["v1"]
+      operations   = ["CREATE"]
+      resources    = ["deployments"]
+      scope        = "Namespaced"
+    }
+  }
+}
@@ -0,0 +1,25 @@
+resource "kubernetes_manifest" "core_pod_template" {
+  manifest = {
+    apiVersion = "v1"
+    kind       = "PodTemplate"
+    metadata = {
+      name      = "pod-template${var.test_prefix}${var.test_suffix}"
+      namespace = "default"
+    }
+    template = {
+      metadata = {
+        labels = {
+          test = "MyPodTemplate"
+        }
+      }
+      spec = {
+        containers = [
+          {
+            name  = "example"
+            image = "nginx:1.23.1"
+          }
+        ]
+      }
+    }
+  }
+}
@@ -0,0 +1,31 @@
+resource "kubernetes_replication_controller_v1" "example" {
+  metadata {
+    name = "replication-controller${var.test_prefix}${var.test_suffix}"
+    labels = {
+      test = "MyReplicationControllerApp"
+    }
+  }
+
+  spec {
+    replicas = 1
+
+    selector = {
+      test = "MyReplicationControllerApp"
+    }
+
+    template {
+      metadata {
+        labels = {
+          test = "MyReplicationControllerApp"
+        }
+      }
+
+      spec {
+        container {
+          image = "nginx:1.23.1"
+          name  = "example"
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,7 @@
+resource "kubernetes_runtime_class_v1" "example" {
+  metadata {
+    name = "runtime${var.test_prefix}${var.test_suffix}"
+  }
+
+  handler = "runc"
+}
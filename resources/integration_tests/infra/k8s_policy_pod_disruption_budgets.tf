@@ -0,0 +1,13 @@
+resource "kubernetes_pod_disruption_budget_v1" "example" {
+  metadata {
+    name = "pdb${var.test_prefix}${var.test_suffix}"
+  }
+
+  spec {
+    min_available = "50%"
+
+    selector {
+      match_labels = kubernetes_deployment.apps_deloyments.spec.0.selector.0.match_labels
+    }
+  }
+}
@@ -0,0 +1,8 @@
+resource "kubernetes_priority_class_v1" "example" {
+  metadata {
+    name = "priority${var.test_prefix}${var.test_suffix}"
+  }
+
+  value       = 1000
+  description = "Priority class used by integration tests"
+}
@@ -2,11 +2,23 @@ package provider
 
 import (
 	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/admissionregistration"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/apiregistration"
 	"github.com/cloudquery/cq-provider-k8s/resources/services/apps"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/autoscaling"
 	"github.com/cloudquery/cq-provider-k8s/resources/services/batch"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/certificates"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/cluster"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/coordination"
 	"github.com/cloudquery/cq-provider-k8s/resources/services/core"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/discovery"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/events"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/metrics"
 	"github.com/cloudquery/cq-provider-k8s/resources/services/networking"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/node"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/policy"
 	"github.com/cloudquery/cq-provider-k8s/resources/services/rbac"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/scheduling"
 	"github.com/cloudquery/cq-provider-sdk/provider"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 )
@@ -27,23 +39,49 @@ func Provider() *provider.Provider {
 			return &client.Config{}
 		},
 		ResourceMap: map[string]*schema.Table{
-			"apps.daemon_sets":            apps.DaemonSets(),
-			"apps.deployments":            apps.Deployments(),
-			"apps.replica_sets":           apps.ReplicaSets(),
-			"apps.stateful_sets":          apps.StatefulSets(),
-			"batch.cron_jobs":             batch.CronJobs(),
-			"batch.jobs":                  batch.Jobs(),
-			"core.endpoints":              core.Endpoints(),
-			"core.limit_ranges":           core.LimitRanges(),
-			"core.namespaces":             core.Namespaces(),
-			"core.nodes":                  core.Nodes(),
-			"core.pods":                   core.Pods(),
-			"core.resource_quotas":        core.ResourceQuotas(),
-			"core.service_accounts":       core.ServiceAccounts(),
-			"core.services":               core.Services(),
-			"networking.network_policies": networking.NetworkPolicies(),
-			"rbac.role_bindings":          rbac.RoleBindings(),
-			"rbac.roles":                  rbac.Roles(),
+			"admissionregistration.mutating_webhook_configurations":      admissionregistration.MutatingWebhookConfigurations(),
+			"admissionregistration.validating_admission_policies":        admissionregistration.ValidatingAdmissionPolicies(),
+			"admissionregistration.validating_admission_policy_bindings": admissionregistration.ValidatingAdmissionPolicyBindings(),
+			"admissionregistration.validating_webhook_configurations":    admissionregistration.ValidatingWebhookConfigurations(),
+			"apiregistration.api_services":                               apiregistration.APIServices(),
+			"apps.controller_revisions":                                  apps.ControllerRevisions(),
+			"apps.daemon_sets":                                           apps.DaemonSets(),
+			"apps.deployments":                                           apps.Deployments(),
+			"apps.replica_sets":                                          apps.ReplicaSets(),
+			"apps.stateful_sets":                                         apps.StatefulSets(),
+			"autoscaling.horizontal_pod_autoscalers":                     autoscaling.HorizontalPodAutoscalers(),
+			"batch.cron_jobs":                                            batch.CronJobs(),
+			"batch.jobs":                                                 batch.Jobs(),
+			"certificates.certificate_signing_requests":                  certificates.CertificateSigningRequests(),
+			"cluster.info":                                               cluster.Info(),
+			"coordination.leases":                                        coordination.Leases(),
+			"core.endpoints":                                             core.Endpoints(),
+			"core.events":                                                core.Events(),
+			"core.limit_ranges":                                          core.LimitRanges(),
+			"core.namespaces":                                            core.Namespaces(),
+			"core.nodes":                                                 core.Nodes(),
+			"core.pod_templates":                                         core.PodTemplates(),
+			"core.pods":                                                  core.Pods(),
+			"core.replication_controllers":                               core.ReplicationControllers(),
+			"core.resource_quotas":                                       core.ResourceQuotas(),
+			"core.service_accounts":                                      core.ServiceAccounts(),
+			"core.service_targets":                                       core.ServiceTargets(),
+			"core.services":                                              core.Services(),
+			"discovery.endpoint_slices":                                  discovery.EndpointSlices(),
+			"events.events":                                              events.Events(),
+			"metrics.node_metrics":                                       metrics.NodeMetrics(),
+			"metrics.pod_metrics":                                        metrics.PodMetrics(),
+			"network.reachability":                                       networking.Reachability(),
+			"networking.network_policies":                                networking.NetworkPolicies(),
+			"networking.pod_isolation":                                   networking.PodIsolation(),
+			"node.runtime_classes":                                       node.RuntimeClasses(),
+			"pod_security.violations":                                    core.PodSecurityViolations(),
+			"policy.pod_disruption_budgets":                              policy.PodDisruptionBudgets(),
+			"rbac.effective_permissions":                                 rbac.EffectivePermissions(),
+			"rbac.escalation_risks":                                      rbac.EscalationRisks(),
+			"rbac.role_bindings":                                         rbac.RoleBindings(),
+			"rbac.roles":                                                 rbac.Roles(),
+			"scheduling.priority_classes":                                scheduling.PriorityClasses(),
 		},
 	}
 }
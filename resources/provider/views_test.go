@@ -13,4 +13,14 @@ func TestViews(t *testing.T) {
 		Provider: Provider(),
 		SQLView:  views.ResourceView,
 	})
+	providertest.HelperTestView(t, providertest.ViewTestCase{
+		Provider: Provider(),
+		SQLView:  views.WorkloadContainersView,
+	})
+}
+
+func TestWorkloadContainersViewUpToDate(t *testing.T) {
+	if views.GenerateWorkloadContainersView(Provider().ResourceMap) != views.WorkloadContainersView {
+		t.Fatal("views/workload_containers.sql is out of date, run go generate ./views")
+	}
 }
@@ -0,0 +1,53 @@
+//go:build mock
+// +build mock
+
+package admissionregistration
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createMutatingWebhookConfigurations(t *testing.T, ctrl *gomock.Controller) client.Services {
+	configurations := mocks.NewMockMutatingWebhookConfigurationsClient(ctrl)
+	var c admissionregistrationv1.MutatingWebhookConfiguration
+	k8sTesting.FakeThroughPointers(t, &c.TypeMeta, &c.ObjectMeta)
+	c.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+
+	var w admissionregistrationv1.MutatingWebhook
+	k8sTesting.FakeThroughPointers(t,
+		&w.Name,
+		&w.ClientConfig.Service,
+		&w.Rules,
+		&w.NamespaceSelector,
+		&w.ObjectSelector,
+		&w.TimeoutSeconds,
+		&w.AdmissionReviewVersions,
+	)
+	failurePolicy := admissionregistrationv1.Fail
+	matchPolicy := admissionregistrationv1.Equivalent
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	w.FailurePolicy = &failurePolicy
+	w.MatchPolicy = &matchPolicy
+	w.SideEffects = &sideEffects
+	reinvocation := admissionregistrationv1.IfNeededReinvocationPolicy
+	w.ReinvocationPolicy = &reinvocation
+	c.Webhooks = []admissionregistrationv1.MutatingWebhook{w}
+
+	configurations.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&admissionregistrationv1.MutatingWebhookConfigurationList{Items: []admissionregistrationv1.MutatingWebhookConfiguration{c}}, nil,
+	)
+	return client.Services{
+		MutatingWebhookConfigurations: configurations,
+	}
+}
+
+func TestMutatingWebhookConfigurations(t *testing.T) {
+	client.K8sMockTestHelper(t, MutatingWebhookConfigurations(), createMutatingWebhookConfigurations, client.TestOptions{})
+}
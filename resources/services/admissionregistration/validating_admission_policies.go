@@ -0,0 +1,317 @@
+package admissionregistration
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ValidatingAdmissionPolicies() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_admissionregistration_validating_admission_policies",
+		Description:  "ValidatingAdmissionPolicy describes the definition of an admission validation policy that accepts or rejects an object without changing it.",
+		Resolver:     fetchAdmissionregistrationValidatingAdmissionPolicies,
+		Multiplex:    client.APIFilterContextMultiplex(client.AdmissionPolicyPaths("validatingadmissionpolicies")...),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within the cluster",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPoliciesOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAdmissionregistrationValidatingAdmissionPoliciesManagedFields,
+			},
+			{
+				Name:          "param_kind_api_version",
+				Description:   "APIVersion of the resource used as parameters of the policy, e.g. \"v1\" or \"rules.example.com/v1\".",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.ParamKind.APIVersion"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "param_kind_kind",
+				Description:   "Kind of the resource used as parameters of the policy, e.g. ConfigMap.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.ParamKind.Kind"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "match_constraints",
+				Description: "MatchConstraints specifies what resources this policy is designed to validate.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAdmissionregistrationValidatingAdmissionPoliciesSpecMatchConstraints,
+			},
+			{
+				Name:        "failure_policy",
+				Description: "failurePolicy defines how to handle failures for the admission policy. Allowed values are Ignore or Fail.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.FailurePolicy"),
+			},
+			{
+				Name:          "audit_annotations",
+				Description:   "auditAnnotations contains CEL expressions which are used to produce audit annotations for the audit event of the API request.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPoliciesSpecAuditAnnotations,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "match_conditions",
+				Description:   "MatchConditions is a list of conditions that must be met for a request to be validated.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPoliciesSpecMatchConditions,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "variables",
+				Description:   "Variables contain definitions of variables that can be used in composition of other expressions.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPoliciesSpecVariables,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "status_observed_generation",
+				Description: "The generation observed by the controller.",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("Status.ObservedGeneration"),
+			},
+			{
+				Name:          "status_type_checking",
+				Description:   "The results of type checking for each expression.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPoliciesStatusTypeChecking,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "status_conditions",
+				Description:   "The conditions represent the latest available observations of a policy's current state.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPoliciesStatusConditions,
+				IgnoreInTests: true,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "k8s_admissionregistration_validating_admission_policy_validations",
+				Description: "Validation specifies the CEL expression which is used to apply the validation.",
+				Resolver:    fetchAdmissionregistrationValidatingAdmissionPolicyValidations,
+				Columns: []schema.Column{
+					{
+						Name:        "validating_admission_policy_cq_id",
+						Description: "Unique CloudQuery ID of k8s_admissionregistration_validating_admission_policies table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "expression",
+						Description: "Expression represents the expression which will be evaluated by CEL.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "message",
+						Description: "Message represents the message displayed when validation fails.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:          "reason",
+						Description:   "Reason represents a machine-readable description of why this validation failed.",
+						Type:          schema.TypeString,
+						IgnoreInTests: true,
+					},
+					{
+						Name:        "message_expression",
+						Description: "messageExpression declares a CEL expression that evaluates to the validation failure message that is returned when this rule fails.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchAdmissionregistrationValidatingAdmissionPolicies(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client).Services().ValidatingAdmissionPolicies
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesSpecMatchConstraints(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.Spec.MatchConstraints)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesSpecAuditAnnotations(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.Spec.AuditAnnotations)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesSpecMatchConditions(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.Spec.MatchConditions)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesSpecVariables(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.Spec.Variables)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesStatusTypeChecking(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.Status.TypeChecking)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPoliciesStatusConditions(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicy)
+	b, err := json.Marshal(p.Status.Conditions)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func fetchAdmissionregistrationValidatingAdmissionPolicyValidations(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	policy := parent.Item.(client.ValidatingAdmissionPolicy)
+	res <- policy.Spec.Validations
+	return nil
+}
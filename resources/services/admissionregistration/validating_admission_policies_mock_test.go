@@ -0,0 +1,38 @@
+//go:build mock
+// +build mock
+
+package admissionregistration
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createValidatingAdmissionPolicies(t *testing.T, ctrl *gomock.Controller) client.Services {
+	policies := mocks.NewMockValidatingAdmissionPoliciesClient(ctrl)
+	var p client.ValidatingAdmissionPolicy
+	k8sTesting.FakeThroughPointers(t,
+		&p.TypeMeta,
+		&p.ObjectMeta,
+		&p.Spec.MatchConstraints,
+		&p.Spec.Validations,
+		&p.Spec.FailurePolicy,
+		&p.Status.ObservedGeneration,
+	)
+	p.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	policies.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&client.ValidatingAdmissionPolicyList{Items: []client.ValidatingAdmissionPolicy{p}}, nil,
+	)
+	return client.Services{
+		ValidatingAdmissionPolicies: policies,
+	}
+}
+
+func TestValidatingAdmissionPolicies(t *testing.T) {
+	client.K8sMockTestHelper(t, ValidatingAdmissionPolicies(), createValidatingAdmissionPolicies, client.TestOptions{})
+}
@@ -0,0 +1,226 @@
+package admissionregistration
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ValidatingAdmissionPolicyBindings() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_admissionregistration_validating_admission_policy_bindings",
+		Description:  "ValidatingAdmissionPolicyBinding binds the ValidatingAdmissionPolicy with parametrized resources.",
+		Resolver:     fetchAdmissionregistrationValidatingAdmissionPolicyBindings,
+		Multiplex:    client.APIFilterContextMultiplex(client.AdmissionPolicyPaths("validatingadmissionpolicybindings")...),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within the cluster",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPolicyBindingsOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAdmissionregistrationValidatingAdmissionPolicyBindingsManagedFields,
+			},
+			{
+				Name:        "policy_name",
+				Description: "PolicyName references a ValidatingAdmissionPolicy name which the binding binds to.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.PolicyName"),
+			},
+			{
+				Name:          "param_ref_name",
+				Description:   "Name of the resource being referenced as parameters of the policy.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.ParamRef.Name"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "param_ref_namespace",
+				Description:   "Namespace of the referenced resource.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.ParamRef.Namespace"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "param_ref_selector",
+				Description:   "Selector used to match multiple param objects by their labels.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAdmissionregistrationValidatingAdmissionPolicyBindingsSpecParamRefSelector,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "param_ref_parameter_not_found_action",
+				Description:   "Controls the behavior of the binding when the resource exists and the parameters are not found: Allow or Deny.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.ParamRef.ParameterNotFoundAction"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "match_resources",
+				Description: "MatchResources declares what resources match this binding and will be validated by it.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAdmissionregistrationValidatingAdmissionPolicyBindingsSpecMatchResources,
+			},
+			{
+				Name:        "validation_actions",
+				Description: "validationActions declares how Validations of the referenced ValidatingAdmissionPolicy are enforced: Deny, Warn and/or Audit.",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("Spec.ValidationActions"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchAdmissionregistrationValidatingAdmissionPolicyBindings(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client).Services().ValidatingAdmissionPolicyBindings
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveAdmissionregistrationValidatingAdmissionPolicyBindingsOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicyBinding)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPolicyBindingsManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicyBinding)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPolicyBindingsSpecParamRefSelector(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicyBinding)
+	if p.Spec.ParamRef == nil {
+		return nil
+	}
+	b, err := json.Marshal(p.Spec.ParamRef.Selector)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAdmissionregistrationValidatingAdmissionPolicyBindingsSpecMatchResources(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.ValidatingAdmissionPolicyBinding)
+	b, err := json.Marshal(p.Spec.MatchResources)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
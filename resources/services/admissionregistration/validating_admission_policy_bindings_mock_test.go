@@ -0,0 +1,37 @@
+//go:build mock
+// +build mock
+
+package admissionregistration
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createValidatingAdmissionPolicyBindings(t *testing.T, ctrl *gomock.Controller) client.Services {
+	bindings := mocks.NewMockValidatingAdmissionPolicyBindingsClient(ctrl)
+	var b client.ValidatingAdmissionPolicyBinding
+	k8sTesting.FakeThroughPointers(t,
+		&b.TypeMeta,
+		&b.ObjectMeta,
+		&b.Spec.PolicyName,
+		&b.Spec.MatchResources,
+	)
+	b.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	b.Spec.ValidationActions = []string{"Deny", "Audit"}
+	bindings.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&client.ValidatingAdmissionPolicyBindingList{Items: []client.ValidatingAdmissionPolicyBinding{b}}, nil,
+	)
+	return client.Services{
+		ValidatingAdmissionPolicyBindings: bindings,
+	}
+}
+
+func TestValidatingAdmissionPolicyBindings(t *testing.T) {
+	client.K8sMockTestHelper(t, ValidatingAdmissionPolicyBindings(), createValidatingAdmissionPolicyBindings, client.TestOptions{})
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package admissionregistration
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationValidatingAdmissionPolicyBindings(t *testing.T) {
+	client.K8sTestHelper(t, ValidatingAdmissionPolicyBindings(), "./snapshots")
+}
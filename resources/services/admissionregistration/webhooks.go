@@ -0,0 +1,156 @@
+package admissionregistration
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// webhook holds the fields shared by validating and mutating webhooks.
+type webhook struct {
+	Name                    string
+	ClientConfig            admissionregistrationv1.WebhookClientConfig
+	Rules                   []admissionregistrationv1.RuleWithOperations
+	FailurePolicy           *admissionregistrationv1.FailurePolicyType
+	MatchPolicy             *admissionregistrationv1.MatchPolicyType
+	NamespaceSelector       *metav1.LabelSelector
+	ObjectSelector          *metav1.LabelSelector
+	SideEffects             *admissionregistrationv1.SideEffectClass
+	TimeoutSeconds          *int32
+	AdmissionReviewVersions []string
+	ReinvocationPolicy      *admissionregistrationv1.ReinvocationPolicyType
+}
+
+// webhookColumns returns the columns of the validating and mutating webhook tables following the parent FK column.
+func webhookColumns() []schema.Column {
+	return []schema.Column{
+		{
+			Name:        "name",
+			Description: "The name of the admission webhook. Name should be fully qualified, e.g., imagepolicy.kubernetes.io, where \"imagepolicy\" is the name of the webhook, and kubernetes.io is the name of the organization.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:          "client_config_url",
+			Description:   "url gives the location of the webhook, in standard URL form (`scheme://host:port/path`). Exactly one of `url` or `service` must be specified.",
+			Type:          schema.TypeString,
+			Resolver:      schema.PathResolver("ClientConfig.URL"),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "client_config_service_namespace",
+			Description:   "The namespace of the service called by the webhook.",
+			Type:          schema.TypeString,
+			Resolver:      schema.PathResolver("ClientConfig.Service.Namespace"),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "client_config_service_name",
+			Description:   "The name of the service called by the webhook.",
+			Type:          schema.TypeString,
+			Resolver:      schema.PathResolver("ClientConfig.Service.Name"),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "client_config_service_path",
+			Description:   "An optional URL path which will be sent in any request to the service.",
+			Type:          schema.TypeString,
+			Resolver:      schema.PathResolver("ClientConfig.Service.Path"),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "client_config_service_port",
+			Description:   "The port on the service that hosting the webhook. Defaults to 443.",
+			Type:          schema.TypeInt,
+			Resolver:      schema.PathResolver("ClientConfig.Service.Port"),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "client_config_ca_bundle_fingerprints",
+			Description:   "Hex encoded SHA-256 fingerprints of the certificates in the PEM encoded CA bundle used to validate the webhook's server certificate. Empty if the system trust roots on the apiserver are used.",
+			Type:          schema.TypeStringArray,
+			Resolver:      resolveWebhookCABundleFingerprints,
+			IgnoreInTests: true,
+		},
+		{
+			Name:        "rules",
+			Description: "Rules describes what operations on what resources/subresources the webhook cares about.",
+			Type:        schema.TypeJSON,
+			Resolver:    resolveWebhookRules,
+		},
+		{
+			Name:        "failure_policy",
+			Description: "FailurePolicy defines how unrecognized errors from the admission endpoint are handled - allowed values are Ignore or Fail. Defaults to Fail.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "match_policy",
+			Description: "matchPolicy defines how the \"rules\" list is used to match incoming requests. Allowed values are \"Exact\" or \"Equivalent\".",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "namespace_selector",
+			Description: "NamespaceSelector decides whether to run the webhook on an object based on whether the namespace for that object matches the selector.",
+			Type:        schema.TypeJSON,
+			Resolver:    resolveWebhookNamespaceSelector,
+		},
+		{
+			Name:        "object_selector",
+			Description: "ObjectSelector decides whether to run the webhook based on if the object has matching labels.",
+			Type:        schema.TypeJSON,
+			Resolver:    resolveWebhookObjectSelector,
+		},
+		{
+			Name:        "side_effects",
+			Description: "SideEffects states whether this webhook has side effects. Acceptable values are: None, NoneOnDryRun.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "timeout_seconds",
+			Description: "TimeoutSeconds specifies the timeout for this webhook. After the timeout passes, the webhook call will be ignored or the API call will fail based on the failure policy.",
+			Type:        schema.TypeInt,
+		},
+		{
+			Name:        "admission_review_versions",
+			Description: "AdmissionReviewVersions is an ordered list of preferred `AdmissionReview` versions the Webhook expects.",
+			Type:        schema.TypeStringArray,
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func resolveWebhookCABundleFingerprints(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	w := resource.Item.(webhook)
+	return diag.WrapError(resource.Set(c.Name, client.CertificateFingerprints(w.ClientConfig.CABundle)))
+}
+func resolveWebhookRules(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	w := resource.Item.(webhook)
+	b, err := json.Marshal(w.Rules)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveWebhookNamespaceSelector(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	w := resource.Item.(webhook)
+	b, err := json.Marshal(w.NamespaceSelector)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveWebhookObjectSelector(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	w := resource.Item.(webhook)
+	b, err := json.Marshal(w.ObjectSelector)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
@@ -0,0 +1,288 @@
+package apiregistration
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func APIServices() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_apiregistration_api_services",
+		Description:  "APIService represents a server for a particular GroupVersion, either served locally by the kube-apiserver or by an aggregated API server.",
+		Resolver:     fetchApiregistrationAPIServices,
+		Multiplex:    client.APIFilterContextMultiplex(client.APIServicesPath),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within the cluster",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveApiregistrationAPIServicesOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveApiregistrationAPIServicesManagedFields,
+			},
+			{
+				Name:        "group",
+				Description: "The API group name this server hosts.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.Group"),
+			},
+			{
+				Name:        "version",
+				Description: "The API version this server hosts, e.g. v1.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.Version"),
+			},
+			{
+				Name:          "service_namespace",
+				Description:   "Namespace of the service fronting the API server. Empty for APIs served locally by the kube-apiserver.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.Service.Namespace"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "service_name",
+				Description:   "Name of the service fronting the API server. Empty for APIs served locally by the kube-apiserver.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.Service.Name"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "service_port",
+				Description:   "Port on the service fronting the API server.",
+				Type:          schema.TypeInt,
+				Resolver:      schema.PathResolver("Spec.Service.Port"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "insecure_skip_tls_verify",
+				Description: "Disables TLS certificate verification when communicating with this server.",
+				Type:        schema.TypeBool,
+				Resolver:    schema.PathResolver("Spec.InsecureSkipTLSVerify"),
+			},
+			{
+				Name:          "ca_bundle_fingerprints",
+				Description:   "Hex encoded SHA-256 fingerprints of the certificates in the PEM encoded CA bundle used to validate the API server's serving certificate.",
+				Type:          schema.TypeStringArray,
+				Resolver:      resolveApiregistrationAPIServicesCABundleFingerprints,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "group_priority_minimum",
+				Description: "The priority this group should have at least. Higher priority means that the group is preferred by clients over lower priority ones.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Spec.GroupPriorityMinimum"),
+			},
+			{
+				Name:        "version_priority",
+				Description: "Controls the ordering of this API version inside of its group.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Spec.VersionPriority"),
+			},
+			{
+				Name:        "available",
+				Description: "Whether the API server is available, according to its Available condition.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveApiregistrationAPIServicesAvailable,
+			},
+			{
+				Name:          "available_reason",
+				Description:   "Unique, one-word, CamelCase reason for the last transition of the Available condition.",
+				Type:          schema.TypeString,
+				Resolver:      resolveApiregistrationAPIServicesAvailableReason,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "available_message",
+				Description:   "Human-readable message indicating details about the last transition of the Available condition.",
+				Type:          schema.TypeString,
+				Resolver:      resolveApiregistrationAPIServicesAvailableMessage,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "available_last_transition_time",
+				Description:   "Last time the Available condition transitioned from one status to another.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      resolveApiregistrationAPIServicesAvailableLastTransitionTime,
+				IgnoreInTests: true,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchApiregistrationAPIServices(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client).Services().APIServices
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveApiregistrationAPIServicesOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.APIService)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveApiregistrationAPIServicesManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.APIService)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveApiregistrationAPIServicesCABundleFingerprints(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.APIService)
+	return diag.WrapError(resource.Set(c.Name, client.CertificateFingerprints(p.Spec.CABundle)))
+}
+func resolveApiregistrationAPIServicesAvailable(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cond := availableCondition(resource.Item.(client.APIService))
+	if cond == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, cond.Status == "True"))
+}
+func resolveApiregistrationAPIServicesAvailableReason(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cond := availableCondition(resource.Item.(client.APIService))
+	if cond == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, cond.Reason))
+}
+func resolveApiregistrationAPIServicesAvailableMessage(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cond := availableCondition(resource.Item.(client.APIService))
+	if cond == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, cond.Message))
+}
+func resolveApiregistrationAPIServicesAvailableLastTransitionTime(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cond := availableCondition(resource.Item.(client.APIService))
+	if cond == nil || cond.LastTransitionTime.IsZero() {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, cond.LastTransitionTime.Time))
+}
+
+// availableCondition returns the Available condition of the APIService, nil if it has not been reported yet.
+func availableCondition(s client.APIService) *client.APIServiceCondition {
+	for i := range s.Status.Conditions {
+		if s.Status.Conditions[i].Type == "Available" {
+			return &s.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+//go:build mock
+// +build mock
+
+package apiregistration
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createAPIServices(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockAPIServicesClient(ctrl)
+	m.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&client.APIServiceList{Items: []client.APIService{fakeAPIService(t)}}, nil,
+	)
+	return client.Services{
+		APIServices: m,
+	}
+}
+
+func fakeAPIService(t *testing.T) client.APIService {
+	var s client.APIService
+	k8sTesting.FakeThroughPointers(t, &s.TypeMeta, &s.ObjectMeta, &s.Spec.Service, &s.Status.Conditions)
+	s.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	s.Spec.Group = "metrics.k8s.io"
+	s.Spec.Version = "v1beta1"
+	s.Spec.GroupPriorityMinimum = 100
+	s.Spec.VersionPriority = 100
+	s.Status.Conditions[0].Type = "Available"
+	s.Status.Conditions[0].Status = "False"
+	s.Status.Conditions[0].Reason = "FailedDiscoveryCheck"
+	return s
+}
+
+func TestAPIServices(t *testing.T) {
+	client.K8sMockTestHelper(t, APIServices(), createAPIServices, client.TestOptions{})
+}
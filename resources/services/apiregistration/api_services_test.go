@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package apiregistration
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationAPIServices(t *testing.T) {
+	client.K8sTestHelper(t, APIServices(), "./snapshots")
+}
@@ -0,0 +1,37 @@
+//go:build mock
+// +build mock
+
+package apps
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createControllerRevisions(t *testing.T, ctrl *gomock.Controller) client.Services {
+	revisions := mocks.NewMockControllerRevisionsClient(ctrl)
+	revisions.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&appsv1.ControllerRevisionList{Items: []appsv1.ControllerRevision{fakeControllerRevision(t)}}, nil,
+	)
+	return client.Services{
+		ControllerRevisions: revisions,
+	}
+}
+
+func fakeControllerRevision(t *testing.T) appsv1.ControllerRevision {
+	var cr appsv1.ControllerRevision
+	k8sTesting.FakeThroughPointers(t, &cr.TypeMeta, &cr.ObjectMeta, &cr.Revision)
+	cr.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	cr.Data.Raw = []byte(`{"spec":{"template":{"spec":{"containers":[{"name":"web","image":"nginx:1.23.1"}]}}}}`)
+	return cr
+}
+
+func TestControllerRevisions(t *testing.T) {
+	client.K8sMockTestHelper(t, ControllerRevisions(), createControllerRevisions, client.TestOptions{})
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package apps
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationControllerRevisions(t *testing.T) {
+	client.K8sTestHelper(t, ControllerRevisions(), "./snapshots")
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 
 	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/core"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -225,7 +227,7 @@ func DaemonSets() *schema.Table {
 				IgnoreInTests: true,
 			},
 		},
-		Relations: []*schema.Table{
+		Relations: append([]*schema.Table{
 			{
 				IgnoreInTests: true,
 				Name:          "k8s_apps_daemon_set_selector_match_expressions",
@@ -289,7 +291,7 @@ func DaemonSets() *schema.Table {
 					},
 				},
 			},
-		},
+		}, core.PodTemplateTables("k8s_apps_daemon_set", daemonSetPodTemplate)...),
 	}
 }
 
@@ -349,3 +351,7 @@ func fetchDaemonSetStatusConditions(ctx context.Context, meta schema.ClientMeta,
 	res <- p.Status.Conditions
 	return nil
 }
+
+func daemonSetPodTemplate(item interface{}) corev1.PodTemplateSpec {
+	return item.(appsv1.DaemonSet).Spec.Template
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 
 	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/core"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -239,7 +241,7 @@ func Deployments() *schema.Table {
 				Resolver:    schema.PathResolver("Status.CollisionCount"),
 			},
 		},
-		Relations: []*schema.Table{
+		Relations: append([]*schema.Table{
 			{
 				Name:        "k8s_apps_deployment_selector_match_expressions",
 				Description: "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
@@ -301,7 +303,7 @@ func Deployments() *schema.Table {
 					},
 				},
 			},
-		},
+		}, core.PodTemplateTables("k8s_apps_deployment", deploymentPodTemplate)...),
 	}
 }
 
@@ -361,3 +363,7 @@ func fetchAppsDeploymentStatusConditions(ctx context.Context, meta schema.Client
 	res <- deployment.Status.Conditions
 	return nil
 }
+
+func deploymentPodTemplate(item interface{}) corev1.PodTemplateSpec {
+	return item.(appsv1.Deployment).Spec.Template
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 
 	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/core"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -88,6 +90,27 @@ func ReplicaSets() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    resolveAppsReplicaSetsOwnerReferences,
 			},
+			{
+				Name:          "root_owner_kind",
+				Description:   "Kind of the top-level owner, found by following the managing controllers in the owner references, e.g. Deployment for pods of a replica set or an unknown custom resource kind such as Rollout.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return ref.Kind }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "root_owner_name",
+				Description:   "Name of the top-level owner.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return ref.Name }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "root_owner_uid",
+				Description:   "UID of the top-level owner.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return string(ref.UID) }),
+				IgnoreInTests: true,
+			},
 			{
 				Name:        "finalizers",
 				Description: "Must be empty before the object is deleted from the registry",
@@ -161,7 +184,7 @@ func ReplicaSets() *schema.Table {
 				Resolver:    schema.PathResolver("Status.ObservedGeneration"),
 			},
 		},
-		Relations: []*schema.Table{
+		Relations: append([]*schema.Table{
 			{
 				Name:        "k8s_apps_replica_set_selector_match_expressions",
 				Description: "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
@@ -223,7 +246,7 @@ func ReplicaSets() *schema.Table {
 					},
 				},
 			},
-		},
+		}, core.PodTemplateTables("k8s_apps_replica_set", replicaSetPodTemplate)...),
 	}
 }
 
@@ -283,3 +306,7 @@ func fetchAppsReplicaSetStatusConditions(ctx context.Context, meta schema.Client
 	res <- p.Status.Conditions
 	return nil
 }
+
+func replicaSetPodTemplate(item interface{}) corev1.PodTemplateSpec {
+	return item.(appsv1.ReplicaSet).Spec.Template
+}
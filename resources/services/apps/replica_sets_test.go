@@ -18,7 +18,7 @@ func createReplicaSets(t *testing.T, ctrl *gomock.Controller) client.Services {
 	setsClient := mocks.NewMockReplicaSetsClient(ctrl)
 	setsClient.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
 		&appsv1.ReplicaSetList{Items: []appsv1.ReplicaSet{fakeReplicaSet(t)}}, nil,
-	)
+	).Times(2) // once for the table and once for the owner index
 	return client.Services{
 		ReplicaSets: setsClient,
 	}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 
 	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/core"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -221,7 +223,7 @@ func StatefulSets() *schema.Table {
 				Resolver:    schema.PathResolver("Status.AvailableReplicas"),
 			},
 		},
-		Relations: []*schema.Table{
+		Relations: append([]*schema.Table{
 			{
 				Name:        "k8s_apps_stateful_set_selector_match_expressions",
 				Description: "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
@@ -283,7 +285,7 @@ func StatefulSets() *schema.Table {
 					},
 				},
 			},
-		},
+		}, core.PodTemplateTables("k8s_apps_stateful_set", statefulSetPodTemplate)...),
 	}
 }
 
@@ -351,3 +353,7 @@ func fetchAppsStatefulSetStatusConditions(ctx context.Context, meta schema.Clien
 	res <- p.Status.Conditions
 	return nil
 }
+
+func statefulSetPodTemplate(item interface{}) corev1.PodTemplateSpec {
+	return item.(appsv1.StatefulSet).Spec.Template
+}
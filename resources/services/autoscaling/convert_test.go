@@ -0,0 +1,35 @@
+package autoscaling
+
+import (
+	"testing"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestConvertHorizontalPodAutoscalerV1(t *testing.T) {
+	target, current := int32(70), int32(35)
+	in := autoscalingv1.HorizontalPodAutoscaler{
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef:                 autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "web", APIVersion: "apps/v1"},
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: &target,
+		},
+		Status: autoscalingv1.HorizontalPodAutoscalerStatus{CurrentReplicas: 2, DesiredReplicas: 3, CurrentCPUUtilizationPercentage: &current},
+	}
+	out := convertHorizontalPodAutoscalerV1(in)
+	if out.Spec.ScaleTargetRef.Name != "web" || out.Spec.MaxReplicas != 5 || out.Status.DesiredReplicas != 3 {
+		t.Fatalf("unexpected conversion: %+v", out)
+	}
+	if len(out.Spec.Metrics) != 1 {
+		t.Fatalf("expected a single metric, got %v", out.Spec.Metrics)
+	}
+	m := flattenMetricSpec(out.Spec.Metrics[0])
+	if m.Type != autoscalingv2.ResourceMetricSourceType || m.Name != string(corev1.ResourceCPU) || *m.Target.AverageUtilization != target {
+		t.Fatalf("unexpected metric: %+v", m)
+	}
+	if *out.Status.CurrentMetrics[0].Resource.Current.AverageUtilization != current {
+		t.Fatalf("unexpected current metrics: %+v", out.Status.CurrentMetrics)
+	}
+}
@@ -0,0 +1,575 @@
+package autoscaling
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// horizontalPodAutoscalerMetric is a flattened autoscalingv2.MetricSpec.
+type horizontalPodAutoscalerMetric struct {
+	Type            autoscalingv2.MetricSourceType
+	Name            string
+	Container       string
+	Selector        *metav1.LabelSelector
+	DescribedObject autoscalingv2.CrossVersionObjectReference
+	Target          autoscalingv2.MetricTarget
+}
+
+// horizontalPodAutoscalerPolicy is a scaling policy of the scale up or scale down behavior.
+type horizontalPodAutoscalerPolicy struct {
+	Direction string
+	autoscalingv2.HPAScalingPolicy
+}
+
+func HorizontalPodAutoscalers() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_autoscaling_horizontal_pod_autoscalers",
+		Description:  "HorizontalPodAutoscaler is the configuration for a horizontal pod autoscaler, which automatically manages the replica count of any resource implementing the scale subresource based on the metrics specified.",
+		Resolver:     fetchAutoscalingHorizontalPodAutoscalers,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within a namespace",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveAutoscalingHorizontalPodAutoscalersOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAutoscalingHorizontalPodAutoscalersManagedFields,
+			},
+			{
+				Name:        "scale_target_ref_kind",
+				Description: "Kind of the referent",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.ScaleTargetRef.Kind"),
+			},
+			{
+				Name:        "scale_target_ref_name",
+				Description: "Name of the referent",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.ScaleTargetRef.Name"),
+			},
+			{
+				Name:        "scale_target_ref_api_version",
+				Description: "API version of the referent",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.ScaleTargetRef.APIVersion"),
+			},
+			{
+				Name:        "min_replicas",
+				Description: "The lower limit for the number of replicas to which the autoscaler can scale down.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Spec.MinReplicas"),
+			},
+			{
+				Name:        "max_replicas",
+				Description: "The upper limit for the number of replicas to which the autoscaler can scale up.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Spec.MaxReplicas"),
+			},
+			{
+				Name:          "behavior_scale_up_stabilization_window_seconds",
+				Description:   "Number of seconds for which past recommendations should be considered while scaling up.",
+				Type:          schema.TypeInt,
+				Resolver:      schema.PathResolver("Spec.Behavior.ScaleUp.StabilizationWindowSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "behavior_scale_up_select_policy",
+				Description:   "Specifies which policy should be used when scaling up. If not set, the default value Max is used.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.Behavior.ScaleUp.SelectPolicy"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "behavior_scale_down_stabilization_window_seconds",
+				Description:   "Number of seconds for which past recommendations should be considered while scaling down.",
+				Type:          schema.TypeInt,
+				Resolver:      schema.PathResolver("Spec.Behavior.ScaleDown.StabilizationWindowSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "behavior_scale_down_select_policy",
+				Description:   "Specifies which policy should be used when scaling down. If not set, the default value Max is used.",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.Behavior.ScaleDown.SelectPolicy"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "status_observed_generation",
+				Description: "The most recent generation observed by this autoscaler.",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("Status.ObservedGeneration"),
+			},
+			{
+				Name:          "status_last_scale_time",
+				Description:   "The last time the HorizontalPodAutoscaler scaled the number of pods, used by the autoscaler to control how often the number of pods is changed.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("Status.LastScaleTime"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "status_current_replicas",
+				Description: "Current number of replicas of pods managed by this autoscaler, as last seen by the autoscaler.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.CurrentReplicas"),
+			},
+			{
+				Name:        "status_desired_replicas",
+				Description: "Desired number of replicas of pods managed by this autoscaler, as last calculated by the autoscaler.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.DesiredReplicas"),
+			},
+			{
+				Name:        "status_current_metrics",
+				Description: "The last read state of the metrics used by this autoscaler.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAutoscalingHorizontalPodAutoscalersStatusCurrentMetrics,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "k8s_autoscaling_horizontal_pod_autoscaler_metrics",
+				Description: "MetricSpec specifies how to scale based on a single metric.",
+				Resolver:    fetchAutoscalingHorizontalPodAutoscalerMetrics,
+				Columns: []schema.Column{
+					{
+						Name:        "horizontal_pod_autoscaler_cq_id",
+						Description: "Unique CloudQuery ID of k8s_autoscaling_horizontal_pod_autoscalers table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "type",
+						Description: "Type of metric source: ContainerResource, External, Object, Pods or Resource.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "name",
+						Description: "Name of the resource (for Resource and ContainerResource metrics) or of the metric (for Pods, Object and External metrics).",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:          "container",
+						Description:   "Name of the container in the pods of the scaling target, set for ContainerResource metrics.",
+						Type:          schema.TypeString,
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "selector",
+						Description:   "Label selector of the metric, set for Pods, Object and External metrics.",
+						Type:          schema.TypeJSON,
+						Resolver:      resolveAutoscalingHorizontalPodAutoscalerMetricsSelector,
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "described_object_kind",
+						Description:   "Kind of the object the metric describes, set for Object metrics.",
+						Type:          schema.TypeString,
+						Resolver:      schema.PathResolver("DescribedObject.Kind"),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "described_object_name",
+						Description:   "Name of the object the metric describes, set for Object metrics.",
+						Type:          schema.TypeString,
+						Resolver:      schema.PathResolver("DescribedObject.Name"),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "described_object_api_version",
+						Description:   "API version of the object the metric describes, set for Object metrics.",
+						Type:          schema.TypeString,
+						Resolver:      schema.PathResolver("DescribedObject.APIVersion"),
+						IgnoreInTests: true,
+					},
+					{
+						Name:        "target_type",
+						Description: "Represents whether the metric type is Utilization, Value, or AverageValue",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("Target.Type"),
+					},
+					{
+						Name:          "target_value",
+						Description:   "The target value of the metric (as a quantity).",
+						Type:          schema.TypeString,
+						Resolver:      client.QuantityStringResolver("Target.Value"),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "target_average_value",
+						Description:   "The target value of the average of the metric across all relevant pods (as a quantity)",
+						Type:          schema.TypeString,
+						Resolver:      client.QuantityStringResolver("Target.AverageValue"),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "target_average_utilization",
+						Description:   "The target value of the average of the resource metric across all relevant pods, represented as a percentage of the requested value of the resource for the pods.",
+						Type:          schema.TypeInt,
+						Resolver:      schema.PathResolver("Target.AverageUtilization"),
+						IgnoreInTests: true,
+					},
+				},
+			},
+			{
+				Name:          "k8s_autoscaling_horizontal_pod_autoscaler_behavior_policies",
+				Description:   "HPAScalingPolicy is a single policy which must hold true for a specified past interval.",
+				Resolver:      fetchAutoscalingHorizontalPodAutoscalerBehaviorPolicies,
+				IgnoreInTests: true,
+				Columns: []schema.Column{
+					{
+						Name:        "horizontal_pod_autoscaler_cq_id",
+						Description: "Unique CloudQuery ID of k8s_autoscaling_horizontal_pod_autoscalers table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "direction",
+						Description: "Scaling direction the policy applies to: ScaleUp or ScaleDown.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "type",
+						Description: "Type is used to specify the scaling policy: Pods or Percent.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "value",
+						Description: "Value contains the amount of change which is permitted by the policy.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "period_seconds",
+						Description: "PeriodSeconds specifies the window of time for which the policy should hold true.",
+						Type:        schema.TypeInt,
+					},
+				},
+			},
+			{
+				Name:        "k8s_autoscaling_horizontal_pod_autoscaler_status_conditions",
+				Description: "HorizontalPodAutoscalerCondition describes the state of a HorizontalPodAutoscaler at a certain point.",
+				Resolver:    fetchAutoscalingHorizontalPodAutoscalerStatusConditions,
+				Columns: []schema.Column{
+					{
+						Name:        "horizontal_pod_autoscaler_cq_id",
+						Description: "Unique CloudQuery ID of k8s_autoscaling_horizontal_pod_autoscalers table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "type",
+						Description: "Type describes the current condition: ScalingActive, AbleToScale or ScalingLimited.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "status",
+						Description: "Status of the condition, one of True, False, Unknown.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "reason",
+						Description: "The reason for the condition's last transition.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "message",
+						Description: "A human readable message indicating details about the transition.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchAutoscalingHorizontalPodAutoscalers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	cl := c.Services().HorizontalPodAutoscalers
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if errors.IsNotFound(err) {
+			c.Logger().Debug("autoscaling/v2 is not served, falling back to autoscaling/v1")
+			return fetchAutoscalingHorizontalPodAutoscalersV1(ctx, c.Services().HorizontalPodAutoscalersV1, res)
+		}
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+
+func fetchAutoscalingHorizontalPodAutoscalersV1(ctx context.Context, cl client.HorizontalPodAutoscalersV1Client, res chan<- interface{}) error {
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		items := make([]autoscalingv2.HorizontalPodAutoscaler, len(result.Items))
+		for i, item := range result.Items {
+			items[i] = convertHorizontalPodAutoscalerV1(item)
+		}
+		res <- items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+
+func resolveAutoscalingHorizontalPodAutoscalersOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(autoscalingv2.HorizontalPodAutoscaler)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAutoscalingHorizontalPodAutoscalersManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(autoscalingv2.HorizontalPodAutoscaler)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveAutoscalingHorizontalPodAutoscalersStatusCurrentMetrics(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(autoscalingv2.HorizontalPodAutoscaler)
+	b, err := json.Marshal(p.Status.CurrentMetrics)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func fetchAutoscalingHorizontalPodAutoscalerMetrics(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(autoscalingv2.HorizontalPodAutoscaler)
+	metrics := make([]horizontalPodAutoscalerMetric, 0, len(p.Spec.Metrics))
+	for _, m := range p.Spec.Metrics {
+		metrics = append(metrics, flattenMetricSpec(m))
+	}
+	res <- metrics
+	return nil
+}
+func resolveAutoscalingHorizontalPodAutoscalerMetricsSelector(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	m := resource.Item.(horizontalPodAutoscalerMetric)
+	if m.Selector == nil {
+		return nil
+	}
+	b, err := json.Marshal(m.Selector)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func fetchAutoscalingHorizontalPodAutoscalerBehaviorPolicies(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(autoscalingv2.HorizontalPodAutoscaler)
+	if p.Spec.Behavior == nil {
+		return nil
+	}
+	var policies []horizontalPodAutoscalerPolicy
+	if p.Spec.Behavior.ScaleUp != nil {
+		for _, policy := range p.Spec.Behavior.ScaleUp.Policies {
+			policies = append(policies, horizontalPodAutoscalerPolicy{Direction: "ScaleUp", HPAScalingPolicy: policy})
+		}
+	}
+	if p.Spec.Behavior.ScaleDown != nil {
+		for _, policy := range p.Spec.Behavior.ScaleDown.Policies {
+			policies = append(policies, horizontalPodAutoscalerPolicy{Direction: "ScaleDown", HPAScalingPolicy: policy})
+		}
+	}
+	res <- policies
+	return nil
+}
+func fetchAutoscalingHorizontalPodAutoscalerStatusConditions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(autoscalingv2.HorizontalPodAutoscaler)
+	res <- p.Status.Conditions
+	return nil
+}
+
+func flattenMetricSpec(m autoscalingv2.MetricSpec) horizontalPodAutoscalerMetric {
+	metric := horizontalPodAutoscalerMetric{Type: m.Type}
+	switch {
+	case m.Resource != nil:
+		metric.Name = string(m.Resource.Name)
+		metric.Target = m.Resource.Target
+	case m.ContainerResource != nil:
+		metric.Name = string(m.ContainerResource.Name)
+		metric.Container = m.ContainerResource.Container
+		metric.Target = m.ContainerResource.Target
+	case m.Pods != nil:
+		metric.Name = m.Pods.Metric.Name
+		metric.Selector = m.Pods.Metric.Selector
+		metric.Target = m.Pods.Target
+	case m.Object != nil:
+		metric.Name = m.Object.Metric.Name
+		metric.Selector = m.Object.Metric.Selector
+		metric.DescribedObject = m.Object.DescribedObject
+		metric.Target = m.Object.Target
+	case m.External != nil:
+		metric.Name = m.External.Metric.Name
+		metric.Selector = m.External.Metric.Selector
+		metric.Target = m.External.Target
+	}
+	return metric
+}
+
+// convertHorizontalPodAutoscalerV1 converts an autoscaling/v1 autoscaler, which only supports a CPU utilization target,
+// into its autoscaling/v2 representation.
+func convertHorizontalPodAutoscalerV1(in autoscalingv1.HorizontalPodAutoscaler) autoscalingv2.HorizontalPodAutoscaler {
+	out := autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: in.ObjectMeta,
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       in.Spec.ScaleTargetRef.Kind,
+				Name:       in.Spec.ScaleTargetRef.Name,
+				APIVersion: in.Spec.ScaleTargetRef.APIVersion,
+			},
+			MinReplicas: in.Spec.MinReplicas,
+			MaxReplicas: in.Spec.MaxReplicas,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			LastScaleTime:      in.Status.LastScaleTime,
+			CurrentReplicas:    in.Status.CurrentReplicas,
+			DesiredReplicas:    in.Status.DesiredReplicas,
+		},
+	}
+	if in.Spec.TargetCPUUtilizationPercentage != nil {
+		out.Spec.Metrics = []autoscalingv2.MetricSpec{{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: in.Spec.TargetCPUUtilizationPercentage,
+				},
+			},
+		}}
+	}
+	if in.Status.CurrentCPUUtilizationPercentage != nil {
+		out.Status.CurrentMetrics = []autoscalingv2.MetricStatus{{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricStatus{
+				Name: corev1.ResourceCPU,
+				Current: autoscalingv2.MetricValueStatus{
+					AverageUtilization: in.Status.CurrentCPUUtilizationPercentage,
+				},
+			},
+		}}
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+//go:build mock
+// +build mock
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createHorizontalPodAutoscalers(t *testing.T, ctrl *gomock.Controller) client.Services {
+	hpas := mocks.NewMockHorizontalPodAutoscalersClient(ctrl)
+	hpas.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&autoscalingv2.HorizontalPodAutoscalerList{Items: []autoscalingv2.HorizontalPodAutoscaler{fakeHorizontalPodAutoscaler(t)}}, nil,
+	)
+	return client.Services{
+		HorizontalPodAutoscalers: hpas,
+	}
+}
+
+func fakeHorizontalPodAutoscaler(t *testing.T) autoscalingv2.HorizontalPodAutoscaler {
+	var hpa autoscalingv2.HorizontalPodAutoscaler
+	k8sTesting.FakeThroughPointers(t,
+		&hpa.TypeMeta,
+		&hpa.ObjectMeta,
+		&hpa.Spec.ScaleTargetRef,
+		&hpa.Spec.MinReplicas,
+		&hpa.Spec.MaxReplicas,
+		&hpa.Status.ObservedGeneration,
+		&hpa.Status.CurrentReplicas,
+		&hpa.Status.DesiredReplicas,
+		&hpa.Status.Conditions,
+	)
+	hpa.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	utilization := int32(80)
+	hpa.Spec.Metrics = []autoscalingv2.MetricSpec{{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name:   corev1.ResourceCPU,
+			Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &utilization},
+		},
+	}}
+	var policy autoscalingv2.HPAScalingPolicy
+	if err := faker.FakeData(&policy); err != nil {
+		t.Fatal(err)
+	}
+	hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{
+		ScaleDown: &autoscalingv2.HPAScalingRules{Policies: []autoscalingv2.HPAScalingPolicy{policy}},
+	}
+	hpa.Status.CurrentMetrics = []autoscalingv2.MetricStatus{{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricStatus{
+			Name:    corev1.ResourceCPU,
+			Current: autoscalingv2.MetricValueStatus{AverageUtilization: &utilization},
+		},
+	}}
+	return hpa
+}
+
+func TestHorizontalPodAutoscalers(t *testing.T) {
+	client.K8sMockTestHelper(t, HorizontalPodAutoscalers(), createHorizontalPodAutoscalers, client.TestOptions{})
+}
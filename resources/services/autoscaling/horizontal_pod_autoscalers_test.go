@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationHorizontalPodAutoscalers(t *testing.T) {
+	client.K8sTestHelper(t, HorizontalPodAutoscalers(), "./snapshots")
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 
 	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/core"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -160,6 +162,7 @@ func CronJobs() *schema.Table {
 				Resolver:    resolveBatchCronJobsStatus,
 			},
 		},
+		Relations: core.PodTemplateTables("k8s_batch_cron_job", cronJobPodTemplate),
 	}
 }
 
@@ -215,3 +218,7 @@ func resolveBatchCronJobsStatus(ctx context.Context, meta schema.ClientMeta, res
 	}
 	return diag.WrapError(resource.Set(c.Name, b))
 }
+
+func cronJobPodTemplate(item interface{}) corev1.PodTemplateSpec {
+	return item.(batchv1.CronJob).Spec.JobTemplate.Spec.Template
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 
 	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/resources/services/core"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -89,6 +91,27 @@ func Jobs() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    resolveBatchJobsOwnerReferences,
 			},
+			{
+				Name:          "root_owner_kind",
+				Description:   "Kind of the top-level owner, found by following the managing controllers in the owner references, e.g. Deployment for pods of a replica set or an unknown custom resource kind such as Rollout.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return ref.Kind }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "root_owner_name",
+				Description:   "Name of the top-level owner.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return ref.Name }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "root_owner_uid",
+				Description:   "UID of the top-level owner.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return string(ref.UID) }),
+				IgnoreInTests: true,
+			},
 			{
 				Name:        "finalizers",
 				Description: "Must be empty before the object is deleted from the registry",
@@ -204,7 +227,7 @@ func Jobs() *schema.Table {
 				Resolver:    schema.PathResolver("Status.UncountedTerminatedPods.Failed"),
 			},
 		},
-		Relations: []*schema.Table{
+		Relations: append([]*schema.Table{
 			{
 				Name:          "k8s_batch_job_selector_match_expressions",
 				Description:   "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
@@ -268,7 +291,7 @@ func Jobs() *schema.Table {
 					},
 				},
 			},
-		},
+		}, core.PodTemplateTables("k8s_batch_job", jobPodTemplate)...),
 	}
 }
 
@@ -328,3 +351,7 @@ func fetchBatchJobStatusConditions(ctx context.Context, meta schema.ClientMeta,
 	res <- job.Status.Conditions
 	return nil
 }
+
+func jobPodTemplate(item interface{}) corev1.PodTemplateSpec {
+	return item.(batchv1.Job).Spec.Template
+}
@@ -31,7 +31,7 @@ func createBatchJobs(t *testing.T, ctrl *gomock.Controller) client.Services {
 	j.Spec.Template.ManagedFields = []metav1.ManagedFieldsEntry{testing.FakeManagedFields(t)}
 	jobs.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
 		&batchv1.JobList{Items: []batchv1.Job{j}}, nil,
-	)
+	).Times(2) // once for the table and once for the owner index
 	return client.Services{
 		Jobs: jobs,
 	}
@@ -0,0 +1,503 @@
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// certificateSigningRequest is a CertificateSigningRequest with its decoded request and issued certificate.
+type certificateSigningRequest struct {
+	certificatesv1.CertificateSigningRequest
+	ParsedRequest     *x509.CertificateRequest
+	IssuedCertificate *x509.Certificate
+}
+
+func CertificateSigningRequests() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_certificates_certificate_signing_requests",
+		Description:  "CertificateSigningRequest objects provide a mechanism to obtain x509 certificates by submitting a certificate signing request, and having it asynchronously approved and issued.",
+		Resolver:     fetchCertificatesCertificateSigningRequests,
+		Multiplex:    client.APIFilterContextMultiplex("/apis/certificates.k8s.io/v1/certificatesigningrequests"),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within the cluster",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveCertificatesCertificateSigningRequestsOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveCertificatesCertificateSigningRequestsManagedFields,
+			},
+			{
+				Name:        "signer_name",
+				Description: "Indicates the requested signer, and is a qualified name, e.g. kubernetes.io/kube-apiserver-client.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.SignerName"),
+			},
+			{
+				Name:          "expiration_seconds",
+				Description:   "The requested duration of validity of the issued certificate.",
+				Type:          schema.TypeInt,
+				Resolver:      schema.PathResolver("Spec.ExpirationSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "usages",
+				Description: "Specifies a set of key usages requested in the issued certificate.",
+				Type:        schema.TypeStringArray,
+				Resolver:    resolveCertificatesCertificateSigningRequestsUsages,
+			},
+			{
+				Name:        "requestor_username",
+				Description: "Name of the user that created the CertificateSigningRequest. Populated by the API server on creation and immutable.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.Username"),
+			},
+			{
+				Name:        "requestor_uid",
+				Description: "UID of the user that created the CertificateSigningRequest. Populated by the API server on creation and immutable.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Spec.UID"),
+			},
+			{
+				Name:        "requestor_groups",
+				Description: "Group membership of the user that created the CertificateSigningRequest. Populated by the API server on creation and immutable.",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("Spec.Groups"),
+			},
+			{
+				Name:          "requestor_extra",
+				Description:   "Extra attributes of the user that created the CertificateSigningRequest. Populated by the API server on creation and immutable.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveCertificatesCertificateSigningRequestsRequestorExtra,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "approved",
+				Description: "True if the request has an Approved condition.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveCertificatesCertificateSigningRequestsApproved,
+			},
+			{
+				Name:        "denied",
+				Description: "True if the request has a Denied condition.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveCertificatesCertificateSigningRequestsDenied,
+			},
+			{
+				Name:        "request_subject",
+				Description: "Subject of the certificate request, in RFC 2253 form. Empty if the request couldn't be decoded.",
+				Type:        schema.TypeString,
+				Resolver:    resolveCertificatesCertificateSigningRequestsRequestSubject,
+			},
+			{
+				Name:        "request_common_name",
+				Description: "Common name of the subject of the certificate request. For client certificates it is the user name the certificate authenticates as.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ParsedRequest.Subject.CommonName"),
+			},
+			{
+				Name:        "request_organizations",
+				Description: "Organizations of the subject of the certificate request. For client certificates they are the groups the certificate authenticates as, e.g. system:masters.",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("ParsedRequest.Subject.Organization"),
+			},
+			{
+				Name:          "request_dns_names",
+				Description:   "DNS subject alternative names of the certificate request.",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ParsedRequest.DNSNames"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "request_ip_addresses",
+				Description:   "IP address subject alternative names of the certificate request.",
+				Type:          schema.TypeInetArray,
+				Resolver:      schema.PathResolver("ParsedRequest.IPAddresses"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "request_email_addresses",
+				Description:   "Email address subject alternative names of the certificate request.",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ParsedRequest.EmailAddresses"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "request_uris",
+				Description:   "URI subject alternative names of the certificate request.",
+				Type:          schema.TypeStringArray,
+				Resolver:      resolveCertificatesCertificateSigningRequestsRequestUris,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "request_key_algorithm",
+				Description: "Algorithm and size of the public key of the certificate request, e.g. RSA-2048, ECDSA-P-256 or Ed25519.",
+				Type:        schema.TypeString,
+				Resolver:    resolveCertificatesCertificateSigningRequestsRequestKeyAlgorithm,
+			},
+			{
+				Name:        "request_signature_algorithm",
+				Description: "Algorithm the certificate request is signed with, e.g. SHA256-RSA.",
+				Type:        schema.TypeString,
+				Resolver:    resolveCertificatesCertificateSigningRequestsRequestSignatureAlgorithm,
+			},
+			{
+				Name:          "certificate_subject",
+				Description:   "Subject of the issued certificate, in RFC 2253 form.",
+				Type:          schema.TypeString,
+				Resolver:      resolveCertificatesCertificateSigningRequestsCertificateSubject,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "certificate_serial_number",
+				Description:   "Serial number of the issued certificate.",
+				Type:          schema.TypeString,
+				Resolver:      resolveCertificatesCertificateSigningRequestsCertificateSerialNumber,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "certificate_not_before",
+				Description:   "Time the issued certificate is valid from.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      schema.PathResolver("IssuedCertificate.NotBefore"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "certificate_not_after",
+				Description:   "Time the issued certificate expires.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      schema.PathResolver("IssuedCertificate.NotAfter"),
+				IgnoreInTests: true,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "k8s_certificates_certificate_signing_request_conditions",
+				Description: "CertificateSigningRequestCondition describes a condition of a CertificateSigningRequest object",
+				Resolver:    fetchCertificatesCertificateSigningRequestConditions,
+				Columns: []schema.Column{
+					{
+						Name:        "certificate_signing_request_cq_id",
+						Description: "Unique CloudQuery ID of k8s_certificates_certificate_signing_requests table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "type",
+						Description: "Type of the condition. Known conditions are \"Approved\", \"Denied\", and \"Failed\".",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "status",
+						Description: "Status of the condition, one of True, False, Unknown.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "reason",
+						Description: "Reason indicates a brief reason for the request state",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "message",
+						Description: "Message contains a human readable message with details about the request state",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:          "last_update_time",
+						Description:   "The time of the last update to this condition",
+						Type:          schema.TypeTimestamp,
+						Resolver:      client.TimeResolver("LastUpdateTime"),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "last_transition_time",
+						Description:   "The time the condition last transitioned from one status to another.",
+						Type:          schema.TypeTimestamp,
+						Resolver:      client.TimeResolver("LastTransitionTime"),
+						IgnoreInTests: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchCertificatesCertificateSigningRequests(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	cl := c.Services().CertificateSigningRequests
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		csrs := make([]certificateSigningRequest, len(result.Items))
+		for i, item := range result.Items {
+			csrs[i] = certificateSigningRequest{CertificateSigningRequest: item}
+			if csrs[i].ParsedRequest, err = parseCertificateRequest(item.Spec.Request); err != nil {
+				c.Logger().Warn("failed to decode certificate signing request", "name", item.Name, "err", err)
+			}
+			if len(item.Status.Certificate) == 0 {
+				continue
+			}
+			if csrs[i].IssuedCertificate, err = parseCertificate(item.Status.Certificate); err != nil {
+				c.Logger().Warn("failed to decode issued certificate", "name", item.Name, "err", err)
+			}
+		}
+		res <- csrs
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveCertificatesCertificateSigningRequestsOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCertificatesCertificateSigningRequestsManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCertificatesCertificateSigningRequestsUsages(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	usages := make([]string, len(p.Spec.Usages))
+	for i, u := range p.Spec.Usages {
+		usages[i] = string(u)
+	}
+	return diag.WrapError(resource.Set(c.Name, usages))
+}
+func resolveCertificatesCertificateSigningRequestsRequestorExtra(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	b, err := json.Marshal(p.Spec.Extra)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCertificatesCertificateSigningRequestsApproved(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	return diag.WrapError(resource.Set(c.Name, hasCondition(p.Status.Conditions, certificatesv1.CertificateApproved)))
+}
+func resolveCertificatesCertificateSigningRequestsDenied(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	return diag.WrapError(resource.Set(c.Name, hasCondition(p.Status.Conditions, certificatesv1.CertificateDenied)))
+}
+func resolveCertificatesCertificateSigningRequestsRequestSubject(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	if p.ParsedRequest == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, p.ParsedRequest.Subject.String()))
+}
+func resolveCertificatesCertificateSigningRequestsRequestUris(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	if p.ParsedRequest == nil {
+		return nil
+	}
+	uris := make([]string, len(p.ParsedRequest.URIs))
+	for i, u := range p.ParsedRequest.URIs {
+		uris[i] = u.String()
+	}
+	return diag.WrapError(resource.Set(c.Name, uris))
+}
+func resolveCertificatesCertificateSigningRequestsRequestKeyAlgorithm(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	if p.ParsedRequest == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, keyAlgorithm(p.ParsedRequest.PublicKey)))
+}
+func resolveCertificatesCertificateSigningRequestsRequestSignatureAlgorithm(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	if p.ParsedRequest == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, p.ParsedRequest.SignatureAlgorithm.String()))
+}
+func resolveCertificatesCertificateSigningRequestsCertificateSubject(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	if p.IssuedCertificate == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, p.IssuedCertificate.Subject.String()))
+}
+func resolveCertificatesCertificateSigningRequestsCertificateSerialNumber(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(certificateSigningRequest)
+	if p.IssuedCertificate == nil {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, p.IssuedCertificate.SerialNumber.String()))
+}
+func fetchCertificatesCertificateSigningRequestConditions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(certificateSigningRequest)
+	res <- p.Status.Conditions
+	return nil
+}
+
+func hasCondition(conditions []certificatesv1.CertificateSigningRequestCondition, t certificatesv1.RequestConditionType) bool {
+	for _, c := range conditions {
+		if c.Type == t && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCertificateRequest decodes a PEM encoded PKCS#10 certificate request.
+func parseCertificateRequest(data []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("no PEM encoded CERTIFICATE REQUEST block found")
+	}
+	return x509.ParseCertificateRequest(block.Bytes)
+}
+
+// parseCertificate decodes the first certificate of a PEM encoded certificate chain.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no PEM encoded CERTIFICATE block found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// keyAlgorithm describes the algorithm and size of a public key, e.g. RSA-2048 or ECDSA-P-256.
+func keyAlgorithm(pub interface{}) string {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA-%d", k.N.BitLen())
+	case *ecdsa.PublicKey:
+		return "ECDSA-" + k.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		return fmt.Sprintf("%T", pub)
+	}
+}
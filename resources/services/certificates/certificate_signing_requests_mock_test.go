@@ -0,0 +1,49 @@
+//go:build mock
+// +build mock
+
+package certificates
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createCertificateSigningRequests(t *testing.T, ctrl *gomock.Controller) client.Services {
+	csrs := mocks.NewMockCertificateSigningRequestsClient(ctrl)
+	var csr certificatesv1.CertificateSigningRequest
+	k8sTesting.FakeThroughPointers(t,
+		&csr.TypeMeta,
+		&csr.ObjectMeta,
+		&csr.Spec.SignerName,
+		&csr.Spec.Username,
+		&csr.Spec.UID,
+		&csr.Spec.Groups,
+	)
+	csr.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	csr.Spec.Request, csr.Status.Certificate = fakeCertificates(t)
+	csr.Spec.Usages = []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth}
+	csr.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{{
+		Type:           certificatesv1.CertificateApproved,
+		Status:         corev1.ConditionTrue,
+		Reason:         "AutoApproved",
+		Message:        "approved by test",
+		LastUpdateTime: metav1.Now(),
+	}}
+	csrs.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&certificatesv1.CertificateSigningRequestList{Items: []certificatesv1.CertificateSigningRequest{csr}}, nil,
+	)
+	return client.Services{
+		CertificateSigningRequests: csrs,
+	}
+}
+
+func TestCertificateSigningRequests(t *testing.T) {
+	client.K8sMockTestHelper(t, CertificateSigningRequests(), createCertificateSigningRequests, client.TestOptions{})
+}
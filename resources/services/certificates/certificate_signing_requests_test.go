@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package certificates
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationCertificateSigningRequests(t *testing.T) {
+	client.K8sTestHelper(t, CertificateSigningRequests(), "./snapshots")
+}
@@ -0,0 +1,73 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeCertificates returns a PEM encoded certificate request for an admin client certificate and a certificate issued for it.
+func fakeCertificates(t *testing.T) (request []byte, certificate []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := pkix.Name{CommonName: "admin", Organization: []string{"system:masters"}}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     subject,
+		DNSNames:    []string{"admin.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      subject,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+}
+
+func TestDecodeCertificates(t *testing.T) {
+	request, certificate := fakeCertificates(t)
+
+	csr, err := parseCertificateRequest(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if csr.Subject.CommonName != "admin" || csr.Subject.Organization[0] != "system:masters" {
+		t.Fatalf("unexpected subject %q", csr.Subject)
+	}
+	if len(csr.DNSNames) != 1 || len(csr.IPAddresses) != 1 {
+		t.Fatalf("unexpected SANs: %v %v", csr.DNSNames, csr.IPAddresses)
+	}
+	if got := keyAlgorithm(csr.PublicKey); got != "ECDSA-P-256" {
+		t.Fatalf("unexpected key algorithm %q", got)
+	}
+
+	cert, err := parseCertificate(certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert.SerialNumber.Int64() != 42 {
+		t.Fatalf("unexpected serial number %v", cert.SerialNumber)
+	}
+
+	if _, err := parseCertificateRequest(certificate); err == nil {
+		t.Fatal("expected an error decoding a certificate as a request")
+	}
+}
@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDetectDistribution(t *testing.T) {
+	node := func(providerID string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: labels}, Spec: corev1.NodeSpec{ProviderID: providerID}}
+	}
+	cases := []struct {
+		name       string
+		gitVersion string
+		apiGroups  []string
+		node       *corev1.Node
+		want       string
+	}{
+		{"eks version", "v1.24.7-eks-fb459a0", nil, nil, "EKS"},
+		{"gke version", "v1.24.5-gke.600", nil, nil, "GKE"},
+		{"k3s version", "v1.25.3+k3s1", nil, nil, "k3s"},
+		{"openshift groups", "v1.24.0+4f0dd4d", []string{"apps", "route.openshift.io"}, nil, "OpenShift"},
+		{"aks node labels", "v1.24.6", nil, node("azure:///subscriptions/x", map[string]string{"kubernetes.azure.com/cluster": "MC_rg"}), "AKS"},
+		{"eks node labels", "v1.24.6", nil, node("aws:///us-east-1a/i-1", map[string]string{"eks.amazonaws.com/nodegroup": "ng"}), "EKS"},
+		{"kind provider id", "v1.25.3", nil, node("kind://docker/kind/kind-control-plane", nil), "kind"},
+		{"unknown", "v1.25.3", []string{"apps"}, node("", map[string]string{"kubernetes.io/os": "linux"}), ""},
+		{"no nodes", "v1.25.3", nil, nil, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := detectDistribution(tc.gitVersion, tc.apiGroups, tc.node); got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
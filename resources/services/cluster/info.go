@@ -0,0 +1,249 @@
+package cluster
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+type clusterInfo struct {
+	Server         string
+	Version        *version.Info
+	Distribution   string
+	AuthMethod     string
+	AuthPlugin     string
+	NamespaceCount *int64
+	NodeCount      *int64
+	PodCount       *int64
+	APIGroups      []string
+}
+
+func Info() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_cluster_info",
+		Description:  "Facts about the cluster of each context, such as its version, distribution and size.",
+		Resolver:     fetchClusterInfo,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"context"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "server",
+				Description: "URL of the API server, as configured in the kube configuration.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "major",
+				Description: "Major version of the API server.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Version.Major"),
+			},
+			{
+				Name:        "minor",
+				Description: "Minor version of the API server.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Version.Minor"),
+			},
+			{
+				Name:        "git_version",
+				Description: "Full version of the API server, e.g. v1.24.3-eks-1234567.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Version.GitVersion"),
+			},
+			{
+				Name:        "platform",
+				Description: "OS and architecture the API server is built for, e.g. linux/amd64.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Version.Platform"),
+			},
+			{
+				Name:          "distribution",
+				Description:   "Kubernetes distribution detected from the version, the API groups and the node labels. One of EKS, GKE, AKS, OpenShift, k3s, kind. Null if it could not be detected.",
+				Type:          schema.TypeString,
+				Resolver:      resolveClusterInfoDistribution,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "auth_method",
+				Description: "How the provider authenticated to the API server. One of exec, auth-provider, token, client-certificate, basic, none.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:          "auth_plugin",
+				Description:   "Name of the exec command or auth provider used to authenticate to the API server.",
+				Type:          schema.TypeString,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "namespace_count",
+				Description: "Number of namespaces in the cluster. Null if the provider is not allowed to list them.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "node_count",
+				Description: "Number of nodes in the cluster. Null if the provider is not allowed to list them.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "pod_count",
+				Description: "Number of pods in the cluster. Null if the provider is not allowed to list them.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "api_groups",
+				Description: "Names of the API groups served by the cluster. The legacy core group is not included.",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("APIGroups"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchClusterInfo(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services()
+
+	info := clusterInfo{Server: c.Server()}
+	info.AuthMethod, info.AuthPlugin = c.AuthMethod()
+
+	v, err := svc.Discovery.ServerVersion()
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	info.Version = v
+
+	groups, err := svc.Discovery.ServerGroups()
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	for _, g := range groups.Groups {
+		info.APIGroups = append(info.APIGroups, g.Name)
+	}
+
+	if info.NamespaceCount, err = countObjects(ctx, c, func(opts metav1.ListOptions) (metav1.ListMeta, int, error) {
+		l, err := svc.Namespaces.List(ctx, opts)
+		if err != nil {
+			return metav1.ListMeta{}, 0, err
+		}
+		return l.ListMeta, len(l.Items), nil
+	}); err != nil {
+		return diag.WrapError(err)
+	}
+	var node *corev1.Node
+	if info.NodeCount, err = countObjects(ctx, c, func(opts metav1.ListOptions) (metav1.ListMeta, int, error) {
+		l, err := svc.Nodes.List(ctx, opts)
+		if err != nil {
+			return metav1.ListMeta{}, 0, err
+		}
+		if node == nil && len(l.Items) > 0 {
+			node = &l.Items[0]
+		}
+		return l.ListMeta, len(l.Items), nil
+	}); err != nil {
+		return diag.WrapError(err)
+	}
+	if info.PodCount, err = countObjects(ctx, c, func(opts metav1.ListOptions) (metav1.ListMeta, int, error) {
+		l, err := svc.Pods.List(ctx, opts)
+		if err != nil {
+			return metav1.ListMeta{}, 0, err
+		}
+		return l.ListMeta, len(l.Items), nil
+	}); err != nil {
+		return diag.WrapError(err)
+	}
+
+	info.Distribution = detectDistribution(v.GitVersion, info.APIGroups, node)
+	res <- info
+	return nil
+}
+
+func resolveClusterInfoDistribution(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(clusterInfo)
+	if p.Distribution == "" {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, p.Distribution))
+}
+
+// countObjects counts the objects of a list, relying on the remaining item count reported by the API server when
+// possible so that the objects don't have to be fetched. Nil is returned if the objects may not be listed.
+func countObjects(ctx context.Context, c *client.Client, list func(opts metav1.ListOptions) (metav1.ListMeta, int, error)) (*int64, error) {
+	var count int64
+	opts := metav1.ListOptions{Limit: 1}
+	for {
+		m, n, err := list(opts)
+		if client.IgnoreForbiddenNotFound(err) {
+			c.Logger().Debug("not allowed to count objects", "err", err)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		count += int64(n)
+		if m.Continue == "" {
+			return &count, nil
+		}
+		if m.RemainingItemCount != nil {
+			count += *m.RemainingItemCount
+			return &count, nil
+		}
+		opts.Limit = 500
+		opts.Continue = m.Continue
+	}
+}
+
+// detectDistribution detects the Kubernetes distribution of a cluster from the version of its API server, the API
+// groups it serves and the labels and provider ID of one of its nodes. Empty if the distribution is not recognized.
+func detectDistribution(gitVersion string, apiGroups []string, node *corev1.Node) string {
+	for _, g := range apiGroups {
+		if strings.HasSuffix(g, ".openshift.io") {
+			return "OpenShift"
+		}
+	}
+	switch {
+	case strings.Contains(gitVersion, "-eks-"):
+		return "EKS"
+	case strings.Contains(gitVersion, "-gke."):
+		return "GKE"
+	case strings.Contains(gitVersion, "+k3s"):
+		return "k3s"
+	}
+	if node == nil {
+		return ""
+	}
+	labels := node.Labels
+	switch {
+	case hasLabelPrefix(labels, "eks.amazonaws.com/"):
+		return "EKS"
+	case hasLabelPrefix(labels, "cloud.google.com/gke-"):
+		return "GKE"
+	case hasLabelPrefix(labels, "kubernetes.azure.com/"):
+		return "AKS"
+	case labels["node.kubernetes.io/instance-type"] == "k3s":
+		return "k3s"
+	case strings.HasPrefix(node.Spec.ProviderID, "kind://"):
+		return "kind"
+	}
+	return ""
+}
+
+func hasLabelPrefix(labels map[string]string, prefix string) bool {
+	for k := range labels {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+	return false
+}
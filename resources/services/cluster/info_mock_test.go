@@ -0,0 +1,57 @@
+//go:build mock
+// +build mock
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func createInfo(t *testing.T, ctrl *gomock.Controller) client.Services {
+	discovery := mocks.NewMockDiscoveryClient(ctrl)
+	discovery.EXPECT().ServerVersion().Return(&version.Info{Major: "1", Minor: "24", GitVersion: "v1.24.7-eks-fb459a0", Platform: "linux/amd64"}, nil)
+	discovery.EXPECT().ServerGroups().Return(&metav1.APIGroupList{Groups: []metav1.APIGroup{{Name: "apps"}, {Name: "batch"}}}, nil)
+
+	remaining := int64(2)
+	namespaces := mocks.NewMockNamespacesClient(ctrl)
+	namespaces.EXPECT().List(gomock.Any(), gomock.Any()).Return(
+		&corev1.NamespaceList{ListMeta: metav1.ListMeta{Continue: "next", RemainingItemCount: &remaining}, Items: []corev1.Namespace{{}}}, nil,
+	)
+	nodes := mocks.NewMockNodesClient(ctrl)
+	nodes.EXPECT().List(gomock.Any(), gomock.Any()).Return(
+		&corev1.NodeList{Items: []corev1.Node{{Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1a/i-1"}}}}, nil,
+	)
+	pods := mocks.NewMockPodsClient(ctrl)
+	gomock.InOrder(
+		pods.EXPECT().List(gomock.Any(), metav1.ListOptions{Limit: 1}).Return(
+			&corev1.PodList{ListMeta: metav1.ListMeta{Continue: "next"}, Items: []corev1.Pod{{}}}, nil,
+		),
+		pods.EXPECT().List(gomock.Any(), metav1.ListOptions{Limit: 500, Continue: "next"}).Return(
+			&corev1.PodList{Items: []corev1.Pod{{}, {}}}, nil,
+		),
+	)
+	return client.Services{
+		Discovery:  discovery,
+		Namespaces: namespaces,
+		Nodes:      nodes,
+		Pods:       pods,
+	}
+}
+
+func TestInfo(t *testing.T) {
+	client.K8sMockTestHelper(t, Info(), createInfo, client.TestOptions{
+		KubeConfig: api.Config{
+			Contexts:  map[string]*api.Context{"testContext": {Cluster: "test", AuthInfo: "test"}},
+			Clusters:  map[string]*api.Cluster{"test": {Server: "https://127.0.0.1:6443"}},
+			AuthInfos: map[string]*api.AuthInfo{"test": {Exec: &api.ExecConfig{Command: "/usr/local/bin/aws"}}},
+		},
+	})
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationInfo(t *testing.T) {
+	client.K8sTestHelper(t, Info(), "./snapshots")
+}
@@ -0,0 +1,41 @@
+//go:build mock
+// +build mock
+
+package coordination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createLeases(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockLeasesClient(ctrl)
+	m.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&coordinationv1.LeaseList{Items: []coordinationv1.Lease{fakeLease(t)}}, nil,
+	)
+	return client.Services{
+		Leases: m,
+	}
+}
+
+func fakeLease(t *testing.T) coordinationv1.Lease {
+	var l coordinationv1.Lease
+	k8sTesting.FakeThroughPointers(t, &l.TypeMeta, &l.ObjectMeta, &l.Spec.HolderIdentity, &l.Spec.LeaseDurationSeconds, &l.Spec.LeaseTransitions)
+	l.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	acquired := metav1.NewMicroTime(time.Now().Add(-time.Hour))
+	renewed := metav1.NewMicroTime(time.Now())
+	l.Spec.AcquireTime = &acquired
+	l.Spec.RenewTime = &renewed
+	return l
+}
+
+func TestLeases(t *testing.T) {
+	client.K8sMockTestHelper(t, Leases(), createLeases, client.TestOptions{})
+}
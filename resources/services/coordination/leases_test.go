@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package coordination
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationLeases(t *testing.T) {
+	client.K8sTestHelper(t, Leases(), "./snapshots")
+}
@@ -0,0 +1,327 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Events() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_core_events",
+		Description:  "Event is a report of an event somewhere in the cluster.",
+		Resolver:     fetchCoreEvents,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within a namespace",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveCoreEventsOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveCoreEventsManagedFields,
+			},
+			{
+				Name:        "involved_object_kind",
+				Description: "Kind of the involved object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InvolvedObject.Kind"),
+			},
+			{
+				Name:        "involved_object_namespace",
+				Description: "Namespace of the involved object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InvolvedObject.Namespace"),
+			},
+			{
+				Name:        "involved_object_name",
+				Description: "Name of the involved object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InvolvedObject.Name"),
+			},
+			{
+				Name:        "involved_object_uid",
+				Description: "UID of the involved object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InvolvedObject.UID"),
+			},
+			{
+				Name:        "involved_object_api_version",
+				Description: "API version of the involved object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InvolvedObject.APIVersion"),
+			},
+			{
+				Name:        "involved_object_resource_version",
+				Description: "Specific resourceVersion to which this reference is made, if any.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InvolvedObject.ResourceVersion"),
+			},
+			{
+				Name:        "involved_object_field_path",
+				Description: "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2].",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InvolvedObject.FieldPath"),
+			},
+			{
+				Name:        "reason",
+				Description: "This should be a short, machine understandable string that gives the reason for the transition into the object's current status.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "message",
+				Description: "A human-readable description of the status of this operation.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "source_component",
+				Description: "Component from which the event is generated.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Source.Component"),
+			},
+			{
+				Name:        "source_host",
+				Description: "Node name on which the event is generated.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Source.Host"),
+			},
+			{
+				Name:          "first_timestamp",
+				Description:   "The time at which the event was first recorded.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("FirstTimestamp"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "last_timestamp",
+				Description:   "The time at which the most recent occurrence of this event was recorded.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("LastTimestamp"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "count",
+				Description: "The number of times this event has occurred.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:        "type",
+				Description: "Type of this event (Normal, Warning), new types could be added in the future",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:          "event_time",
+				Description:   "Time when this Event was first observed.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("EventTime"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "series_count",
+				Description:   "Number of occurrences in this series up to the last heartbeat time",
+				Type:          schema.TypeInt,
+				Resolver:      schema.PathResolver("Series.Count"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "series_last_observed_time",
+				Description:   "Time of the last occurrence observed",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("Series.LastObservedTime"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "action",
+				Description: "What action was taken/failed regarding to the Regarding object.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:          "related",
+				Description:   "Optional secondary object for more complex actions.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveCoreEventsRelated,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "reporting_component",
+				Description: "Name of the controller that emitted this Event, e.g. `kubernetes.io/kubelet`.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ReportingController"),
+			},
+			{
+				Name:        "reporting_instance",
+				Description: "ID of the controller instance, e.g. `kubelet-xyzf`.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "last_seen",
+				Description: "The most recent time the event was observed, taken from the series, last timestamp, event time, first timestamp or creation timestamp, whichever is the latest.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    resolveCoreEventsLastSeen,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchCoreEvents(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	cl := c.Services().Events
+	cutoff := c.EventsCutoff()
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- client.EventsSeenSince(result.Items, cutoff, eventLastSeen)
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveCoreEventsOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(corev1.Event)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCoreEventsManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(corev1.Event)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCoreEventsRelated(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(corev1.Event)
+	if p.Related == nil {
+		return nil
+	}
+	b, err := json.Marshal(p.Related)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCoreEventsLastSeen(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(corev1.Event)
+	return diag.WrapError(resource.Set(c.Name, eventLastSeen(p)))
+}
+
+// eventLastSeen returns the latest of the times an event records. Depending on the reporter only some of them are set.
+func eventLastSeen(e corev1.Event) time.Time {
+	times := []time.Time{e.CreationTimestamp.Time, e.FirstTimestamp.Time, e.LastTimestamp.Time, e.EventTime.Time}
+	if e.Series != nil {
+		times = append(times, e.Series.LastObservedTime.Time)
+	}
+	return client.EventLastSeen(times...)
+}
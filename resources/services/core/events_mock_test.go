@@ -0,0 +1,47 @@
+//go:build mock
+// +build mock
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createCoreEvents(t *testing.T, ctrl *gomock.Controller) client.Services {
+	events := mocks.NewMockEventsClient(ctrl)
+	var e corev1.Event
+	k8sTesting.FakeThroughPointers(t,
+		&e.TypeMeta,
+		&e.ObjectMeta,
+		&e.InvolvedObject,
+		&e.Reason,
+		&e.Message,
+		&e.Source,
+		&e.Count,
+		&e.Type,
+		&e.Action,
+		&e.ReportingController,
+		&e.ReportingInstance,
+	)
+	e.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	e.FirstTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	e.LastTimestamp = metav1.Now()
+	events.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.EventList{Items: []corev1.Event{e}}, nil,
+	)
+	return client.Services{
+		Events: events,
+	}
+}
+
+func TestCoreEvents(t *testing.T) {
+	client.K8sMockTestHelper(t, Events(), createCoreEvents, client.TestOptions{})
+}
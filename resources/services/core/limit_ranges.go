@@ -170,6 +170,116 @@ func LimitRanges() *schema.Table {
 						Type:          schema.TypeJSON,
 						IgnoreInTests: true,
 					},
+					{
+						Name:        "cpu_max_millicores",
+						Description: "CPU maximum usage constraint, in millicores.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListMilliValueResolver("Max", corev1.ResourceCPU),
+					},
+					{
+						Name:          "cpu_min_millicores",
+						Description:   "CPU minimum usage constraint, in millicores.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListMilliValueResolver("Min", corev1.ResourceCPU),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "cpu_default_millicores",
+						Description:   "CPU default limit applied when the limit is omitted, in millicores.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListMilliValueResolver("Default", corev1.ResourceCPU),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "cpu_default_request_millicores",
+						Description:   "CPU default request applied when the request is omitted, in millicores.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListMilliValueResolver("DefaultRequest", corev1.ResourceCPU),
+						IgnoreInTests: true,
+					},
+					{
+						Name:        "memory_max_bytes",
+						Description: "Memory maximum usage constraint, in bytes.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListValueResolver("Max", corev1.ResourceMemory),
+					},
+					{
+						Name:          "memory_min_bytes",
+						Description:   "Memory minimum usage constraint, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("Min", corev1.ResourceMemory),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "memory_default_bytes",
+						Description:   "Memory default limit applied when the limit is omitted, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("Default", corev1.ResourceMemory),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "memory_default_request_bytes",
+						Description:   "Memory default request applied when the request is omitted, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("DefaultRequest", corev1.ResourceMemory),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "ephemeral_storage_max_bytes",
+						Description:   "Ephemeral storage maximum usage constraint, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("Max", corev1.ResourceEphemeralStorage),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "ephemeral_storage_min_bytes",
+						Description:   "Ephemeral storage minimum usage constraint, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("Min", corev1.ResourceEphemeralStorage),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "ephemeral_storage_default_bytes",
+						Description:   "Ephemeral storage default limit applied when the limit is omitted, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("Default", corev1.ResourceEphemeralStorage),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "ephemeral_storage_default_request_bytes",
+						Description:   "Ephemeral storage default request applied when the request is omitted, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("DefaultRequest", corev1.ResourceEphemeralStorage),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "gpu_max",
+						Description:   "GPU maximum usage constraint, summed over the GPU extended resources of every vendor.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListGPUResolver("Max", ""),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "gpu_min",
+						Description:   "GPU minimum usage constraint, summed over the GPU extended resources of every vendor.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListGPUResolver("Min", ""),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "gpu_default",
+						Description:   "GPU default limit applied when the limit is omitted, summed over the GPU extended resources of every vendor.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListGPUResolver("Default", ""),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "gpu_default_request",
+						Description:   "GPU default request applied when the request is omitted, summed over the GPU extended resources of every vendor.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListGPUResolver("DefaultRequest", ""),
+						IgnoreInTests: true,
+					},
 				},
 			},
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/cloudquery/cq-provider-k8s/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/thoas/go-funk"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -148,6 +149,56 @@ func Nodes() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    schema.PathResolver("Status.Allocatable"),
 			},
+			{
+				Name:        "cpu_capacity_millicores",
+				Description: "CPU capacity of the node, in millicores.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListMilliValueResolver("Status.Capacity", corev1.ResourceCPU),
+			},
+			{
+				Name:        "cpu_allocatable_millicores",
+				Description: "CPU of the node available for scheduling, in millicores.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListMilliValueResolver("Status.Allocatable", corev1.ResourceCPU),
+			},
+			{
+				Name:        "memory_capacity_bytes",
+				Description: "Memory capacity of the node, in bytes.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListValueResolver("Status.Capacity", corev1.ResourceMemory),
+			},
+			{
+				Name:        "memory_allocatable_bytes",
+				Description: "Memory of the node available for scheduling, in bytes.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListValueResolver("Status.Allocatable", corev1.ResourceMemory),
+			},
+			{
+				Name:        "ephemeral_storage_capacity_bytes",
+				Description: "Ephemeral storage capacity of the node, in bytes.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListValueResolver("Status.Capacity", corev1.ResourceEphemeralStorage),
+			},
+			{
+				Name:        "ephemeral_storage_allocatable_bytes",
+				Description: "Ephemeral storage of the node available for scheduling, in bytes.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListValueResolver("Status.Allocatable", corev1.ResourceEphemeralStorage),
+			},
+			{
+				Name:          "gpu_capacity",
+				Description:   "GPU capacity of the node, summed over the GPU extended resources of every vendor.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListGPUResolver("Status.Capacity", ""),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "gpu_allocatable",
+				Description:   "GPU of the node available for scheduling, summed over the GPU extended resources of every vendor.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListGPUResolver("Status.Allocatable", ""),
+				IgnoreInTests: true,
+			},
 			{
 				Name:        "phase",
 				Description: "NodePhase is the recently observed lifecycle phase of the node.",
@@ -282,6 +333,32 @@ func Nodes() *schema.Table {
 						Description: "The size of the image in bytes.",
 						Type:        schema.TypeBigInt,
 					},
+					{
+						Name:        "registry",
+						Description: "Registry the image was pulled from.",
+						Type:        schema.TypeString,
+						Resolver:    resolveCoreNodeImageReference(func(r client.ImageReference) string { return r.Registry }),
+					},
+					{
+						Name:        "repository",
+						Description: "Repository of the image in its registry, e.g. library/nginx.",
+						Type:        schema.TypeString,
+						Resolver:    resolveCoreNodeImageReference(func(r client.ImageReference) string { return r.Repository }),
+					},
+					{
+						Name:          "tags",
+						Description:   "Tags the image is known by on the node.",
+						Type:          schema.TypeStringArray,
+						Resolver:      resolveCoreNodeImageTags,
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "digest",
+						Description:   "Digest of the image, e.g. sha256:4ed64c2e.",
+						Type:          schema.TypeString,
+						Resolver:      resolveCoreNodeImageReference(func(r client.ImageReference) string { return r.Digest }),
+						IgnoreInTests: true,
+					},
 				},
 			},
 			{
@@ -390,6 +467,29 @@ func fetchCoreNodeImages(ctx context.Context, meta schema.ClientMeta, parent *sc
 	return nil
 }
 
+func resolveCoreNodeImageReference(component func(client.ImageReference) string) schema.ColumnResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		image := resource.Item.(corev1.ContainerImage)
+		for _, name := range image.Names {
+			if v := component(client.ParseImageReference(name)); v != "" {
+				return diag.WrapError(resource.Set(c.Name, v))
+			}
+		}
+		return nil
+	}
+}
+
+func resolveCoreNodeImageTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	image := resource.Item.(corev1.ContainerImage)
+	tags := make([]string, 0, len(image.Names))
+	for _, name := range image.Names {
+		if ref := client.ParseImageReference(name); ref.Digest == "" && !funk.ContainsString(tags, ref.Tag) {
+			tags = append(tags, ref.Tag)
+		}
+	}
+	return diag.WrapError(resource.Set(c.Name, tags))
+}
+
 func fetchCoreNodeVolumesAttached(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
 	node := parent.Item.(corev1.Node)
 	res <- node.Status.VolumesAttached
@@ -0,0 +1,246 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// podSecurityViolation is a violation of the Pod Security Standards by a pod or by the pod template of a workload.
+type podSecurityViolation struct {
+	client.PodSecurityViolation
+	Version   string
+	UID       string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// podSecurityLister lists the objects of a kind running pods, with the path of their pod template.
+type podSecurityLister struct {
+	kind         string
+	templatePath string
+	list         func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error)
+}
+
+func PodSecurityViolations() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_pod_security_violations",
+		Description:  "Violations of the baseline and restricted levels of the Pod Security Standards by pods and by the pod templates of workloads, evaluated against the version enforced in their namespace.",
+		Resolver:     fetchCorePodSecurityViolations,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "uid",
+				Description: "UID of the pod or workload.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("UID"),
+			},
+			{
+				Name:        "kind",
+				Description: "Kind of the pod or workload, e.g. Pod or Deployment.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace of the pod or workload.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "name",
+				Description: "Name of the pod or workload.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "version",
+				Description: "Version of the Pod Security Standards evaluated, from the pod-security.kubernetes.io/enforce-version label of the namespace, e.g. v1.24 or latest.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "level",
+				Description: "Level of the Pod Security Standards the check belongs to: baseline or restricted.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "check_id",
+				Description: "Identifier of the failed check, e.g. host_namespaces or capabilities_restricted.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("CheckID"),
+			},
+			{
+				Name:        "field_path",
+				Description: "Path of the field failing the check, e.g. spec.template.spec.containers[0].securityContext.privileged.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "message",
+				Description: "Description of the violation.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchCorePodSecurityViolations(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client)
+	for _, l := range podSecurityListers(cl.Services()) {
+		opts := metav1.ListOptions{}
+		for {
+			result, err := l.list(ctx, opts)
+			if err != nil {
+				if errors.IsForbidden(err) || errors.IsNotFound(err) {
+					cl.Logger().Debug("skipping pod security of objects that can't be listed", "kind", l.kind, "err", err)
+					break
+				}
+				return diag.WrapError(err)
+			}
+			if err := apimeta.EachListItem(result, func(obj runtime.Object) error {
+				m, err := apimeta.Accessor(obj)
+				if err != nil {
+					return err
+				}
+				version, err := cl.PodSecurityVersion(ctx, m.GetNamespace())
+				if err != nil {
+					return err
+				}
+				if violations := resolvePodSecurityViolations(l, version, obj); len(violations) > 0 {
+					res <- violations
+				}
+				return nil
+			}); err != nil {
+				return diag.WrapError(err)
+			}
+			list, err := apimeta.ListAccessor(result)
+			if err != nil {
+				return diag.WrapError(err)
+			}
+			if list.GetContinue() == "" {
+				break
+			}
+			opts.Continue = list.GetContinue()
+		}
+	}
+	return nil
+}
+
+// resolvePodSecurityViolations evaluates the given version of the Pod Security Standards against a pod or the pod
+// template of a workload.
+func resolvePodSecurityViolations(l podSecurityLister, version string, obj runtime.Object) []podSecurityViolation {
+	m, err := apimeta.Accessor(obj)
+	if err != nil {
+		return nil
+	}
+	template, ok := podSecurityTemplate(obj)
+	if !ok {
+		return nil
+	}
+	var violations []podSecurityViolation
+	for _, v := range client.EvaluatePodSecurity(version, template.ObjectMeta, template.Spec) {
+		v.FieldPath = l.templatePath + v.FieldPath
+		violations = append(violations, podSecurityViolation{
+			PodSecurityViolation: v,
+			Version:              version,
+			UID:                  string(m.GetUID()),
+			Kind:                 l.kind,
+			Namespace:            m.GetNamespace(),
+			Name:                 m.GetName(),
+		})
+	}
+	return violations
+}
+
+// podSecurityTemplate returns the pod, or the pod template of a workload, the Pod Security Standards apply to.
+func podSecurityTemplate(obj runtime.Object) (corev1.PodTemplateSpec, bool) {
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		return corev1.PodTemplateSpec{ObjectMeta: o.ObjectMeta, Spec: o.Spec}, true
+	case *corev1.PodTemplate:
+		return o.Template, true
+	case *corev1.ReplicationController:
+		if o.Spec.Template == nil {
+			return corev1.PodTemplateSpec{}, false
+		}
+		return *o.Spec.Template, true
+	case *appsv1.Deployment:
+		return o.Spec.Template, true
+	case *appsv1.DaemonSet:
+		return o.Spec.Template, true
+	case *appsv1.StatefulSet:
+		return o.Spec.Template, true
+	case *appsv1.ReplicaSet:
+		return o.Spec.Template, true
+	case *batchv1.Job:
+		return o.Spec.Template, true
+	case *batchv1.CronJob:
+		return o.Spec.JobTemplate.Spec.Template, true
+	}
+	return corev1.PodTemplateSpec{}, false
+}
+
+// podSecurityListers returns the listers of the pods and workloads the Pod Security Standards are evaluated against,
+// skipping the ones without a service.
+func podSecurityListers(s client.Services) []podSecurityLister {
+	var listers []podSecurityLister
+	if s.Pods != nil {
+		listers = append(listers, podSecurityLister{kind: "Pod", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.Pods.List(ctx, opts)
+		}})
+	}
+	if s.PodTemplates != nil {
+		listers = append(listers, podSecurityLister{kind: "PodTemplate", templatePath: "template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.PodTemplates.List(ctx, opts)
+		}})
+	}
+	if s.ReplicationControllers != nil {
+		listers = append(listers, podSecurityLister{kind: "ReplicationController", templatePath: "spec.template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.ReplicationControllers.List(ctx, opts)
+		}})
+	}
+	if s.Deployments != nil {
+		listers = append(listers, podSecurityLister{kind: "Deployment", templatePath: "spec.template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.Deployments.List(ctx, opts)
+		}})
+	}
+	if s.DaemonSets != nil {
+		listers = append(listers, podSecurityLister{kind: "DaemonSet", templatePath: "spec.template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.DaemonSets.List(ctx, opts)
+		}})
+	}
+	if s.StatefulSets != nil {
+		listers = append(listers, podSecurityLister{kind: "StatefulSet", templatePath: "spec.template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.StatefulSets.List(ctx, opts)
+		}})
+	}
+	if s.ReplicaSets != nil {
+		listers = append(listers, podSecurityLister{kind: "ReplicaSet", templatePath: "spec.template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.ReplicaSets.List(ctx, opts)
+		}})
+	}
+	if s.Jobs != nil {
+		listers = append(listers, podSecurityLister{kind: "Job", templatePath: "spec.template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.Jobs.List(ctx, opts)
+		}})
+	}
+	if s.CronJobs != nil {
+		listers = append(listers, podSecurityLister{kind: "CronJob", templatePath: "spec.jobTemplate.spec.template.", list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return s.CronJobs.List(ctx, opts)
+		}})
+	}
+	return listers
+}
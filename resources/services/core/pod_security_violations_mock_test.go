@@ -0,0 +1,41 @@
+//go:build mock
+// +build mock
+
+package core
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createCorePodSecurityViolations(t *testing.T, ctrl *gomock.Controller) client.Services {
+	pod := k8sTesting.FakePod(t)
+	pod.Spec.HostNetwork = true
+
+	pods := mocks.NewMockPodsClient(ctrl)
+	pods.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.PodList{Items: []corev1.Pod{pod}}, nil,
+	)
+	namespace := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   pod.Namespace,
+		Labels: map[string]string{client.PodSecurityEnforceVersionLabel: "v1.24"},
+	}}
+	namespaces := mocks.NewMockNamespacesClient(ctrl)
+	namespaces.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.NamespaceList{Items: []corev1.Namespace{namespace}}, nil,
+	)
+	return client.Services{
+		Pods:       pods,
+		Namespaces: namespaces,
+	}
+}
+
+func TestCorePodSecurityViolations(t *testing.T) {
+	client.K8sMockTestHelper(t, PodSecurityViolations(), createCorePodSecurityViolations, client.TestOptions{})
+}
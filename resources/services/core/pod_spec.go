@@ -0,0 +1,818 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// podSpec builds the tables describing the containers and volumes of a pod spec, shared by pods and the pod
+// templates of workloads.
+type podSpec struct {
+	// prefix is the name of the parent table in singular, e.g. k8s_apps_deployment.
+	prefix string
+	// template returns the pod template of a parent table item.
+	template func(item interface{}) corev1.PodTemplateSpec
+	// pod is set for pods, whose container tables also have columns resolved from the pod status.
+	pod bool
+}
+
+// PodTemplateTables returns the init container, container and volume tables of the pod template of a workload.
+// prefix is the name of the workload table in singular, e.g. k8s_apps_deployment.
+func PodTemplateTables(prefix string, template func(item interface{}) corev1.PodTemplateSpec) []*schema.Table {
+	s := podSpec{prefix: prefix, template: template}
+	return []*schema.Table{s.containersTable(true), s.containersTable(false), s.volumesTable()}
+}
+
+// containersTable returns the table of the regular or init containers of the pod spec, with child tables of their
+// ports, environment variables, volume mounts and volume devices.
+func (s podSpec) containersTable(init bool) *schema.Table {
+	name, statuses := s.prefix+"_containers", func(st corev1.PodStatus) []corev1.ContainerStatus { return st.ContainerStatuses }
+	if init {
+		name, statuses = s.prefix+"_init_containers", func(st corev1.PodStatus) []corev1.ContainerStatus { return st.InitContainerStatuses }
+	}
+	singular := strings.TrimSuffix(name, "s")
+	columns := []schema.Column{
+		parentIDColumn(s.prefix + "s"),
+		{
+			Name:        "name",
+			Description: "Name of the container specified as a DNS_LABEL.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "image",
+			Description: "Docker image name.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "image_registry",
+			Description: "Registry the image is pulled from, docker.io if the image name has no registry.",
+			Type:        schema.TypeString,
+			Resolver:    client.ImageReferenceResolver("Image", func(r client.ImageReference) string { return r.Registry }),
+		},
+		{
+			Name:        "image_repository",
+			Description: "Repository of the image in its registry, e.g. library/nginx.",
+			Type:        schema.TypeString,
+			Resolver:    client.ImageReferenceResolver("Image", func(r client.ImageReference) string { return r.Repository }),
+		},
+		{
+			Name:          "image_tag",
+			Description:   "Tag of the image, latest if the image is referenced by neither a tag nor a digest.",
+			Type:          schema.TypeString,
+			Resolver:      client.ImageReferenceResolver("Image", func(r client.ImageReference) string { return r.Tag }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "image_digest",
+			Description:   "Digest the image is pinned to, e.g. sha256:4ed64c2e.",
+			Type:          schema.TypeString,
+			Resolver:      client.ImageReferenceResolver("Image", func(r client.ImageReference) string { return r.Digest }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:        "image_pinned_by_digest",
+			Description: "Whether the image is referenced by digest, so that the same image is always run.",
+			Type:        schema.TypeBool,
+			Resolver:    client.ImagePinnedByDigestResolver("Image"),
+		},
+	}
+	if s.pod {
+		columns = append(columns, schema.Column{
+			Name:          "image_id",
+			Description:   "Digest of the image the container runs, resolved by the container runtime and reported in the container status.",
+			Type:          schema.TypeString,
+			Resolver:      resolvePodContainerImageID(statuses),
+			IgnoreInTests: true,
+		})
+	}
+	columns = append(columns, []schema.Column{
+		{
+			Name:        "command",
+			Description: "Entrypoint array",
+			Type:        schema.TypeStringArray,
+		},
+		{
+			Name:        "args",
+			Description: "Arguments to the entrypoint.",
+			Type:        schema.TypeStringArray,
+		},
+		{
+			Name:        "working_dir",
+			Description: "Container's working directory.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:          "env_from",
+			Description:   "List of sources to populate environment variables in the container.",
+			Type:          schema.TypeJSON,
+			Resolver:      resolveContainerJSONField(func(c corev1.Container) interface{} { return c.EnvFrom }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:        "resources_limits",
+			Description: "Limits describes the maximum amount of compute resources allowed.",
+			Type:        schema.TypeJSON,
+			Resolver:    schema.PathResolver("Resources.Limits"),
+		},
+		{
+			Name:        "resources_requests",
+			Description: "Requests describes the minimum amount of compute resources required.",
+			Type:        schema.TypeJSON,
+			Resolver:    schema.PathResolver("Resources.Requests"),
+		},
+		{
+			Name:        "cpu_request_millicores",
+			Description: "CPU requested by the container, in millicores.",
+			Type:        schema.TypeBigInt,
+			Resolver:    client.ResourceListMilliValueResolver("Resources.Requests", corev1.ResourceCPU),
+		},
+		{
+			Name:        "cpu_limit_millicores",
+			Description: "CPU limit of the container, in millicores.",
+			Type:        schema.TypeBigInt,
+			Resolver:    client.ResourceListMilliValueResolver("Resources.Limits", corev1.ResourceCPU),
+		},
+		{
+			Name:        "memory_request_bytes",
+			Description: "Memory requested by the container, in bytes.",
+			Type:        schema.TypeBigInt,
+			Resolver:    client.ResourceListValueResolver("Resources.Requests", corev1.ResourceMemory),
+		},
+		{
+			Name:        "memory_limit_bytes",
+			Description: "Memory limit of the container, in bytes.",
+			Type:        schema.TypeBigInt,
+			Resolver:    client.ResourceListValueResolver("Resources.Limits", corev1.ResourceMemory),
+		},
+		{
+			Name:          "ephemeral_storage_request_bytes",
+			Description:   "Ephemeral storage requested by the container, in bytes.",
+			Type:          schema.TypeBigInt,
+			Resolver:      client.ResourceListValueResolver("Resources.Requests", corev1.ResourceEphemeralStorage),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "ephemeral_storage_limit_bytes",
+			Description:   "Ephemeral storage limit of the container, in bytes.",
+			Type:          schema.TypeBigInt,
+			Resolver:      client.ResourceListValueResolver("Resources.Limits", corev1.ResourceEphemeralStorage),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "gpu_request",
+			Description:   "GPU requested by the container, summed over the GPU extended resources of every vendor.",
+			Type:          schema.TypeBigInt,
+			Resolver:      client.ResourceListGPUResolver("Resources.Requests", ""),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "gpu_limit",
+			Description:   "GPU limit of the container, summed over the GPU extended resources of every vendor.",
+			Type:          schema.TypeBigInt,
+			Resolver:      client.ResourceListGPUResolver("Resources.Limits", ""),
+			IgnoreInTests: true,
+		},
+		{
+			Name:        "liveness_probe",
+			Description: "Periodic probe of container liveness.",
+			Type:        schema.TypeJSON,
+			Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.LivenessProbe }),
+		},
+		{
+			Name:        "readiness_probe",
+			Description: "Periodic probe of container service readiness.",
+			Type:        schema.TypeJSON,
+			Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.ReadinessProbe }),
+		},
+		{
+			Name:        "startup_probe",
+			Description: "Startup probe indicates that the Pod has successfully initialized.",
+			Type:        schema.TypeJSON,
+			Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.StartupProbe }),
+		},
+		{
+			Name:          "lifecycle",
+			Description:   "Actions that the management system should take in response to container lifecycle events.",
+			Type:          schema.TypeJSON,
+			Resolver:      resolveContainerJSONField(func(c corev1.Container) interface{} { return c.Lifecycle }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:        "termination_message_path",
+			Description: "Path at which the file to which the container's termination message will be written is mounted into the container's filesystem.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "termination_message_policy",
+			Description: "Indicate how the termination message should be populated.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "image_pull_policy",
+			Description: "Image pull policy.",
+			Type:        schema.TypeString,
+		},
+		{
+			Name:        "security_context",
+			Description: "security options the container should be run with.",
+			Type:        schema.TypeJSON,
+			Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.SecurityContext }),
+		},
+		{
+			Name:        "privileged",
+			Description: "Whether the container runs in privileged mode, equivalent to root on the host.",
+			Type:        schema.TypeBool,
+			Resolver:    resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.Privileged }),
+		},
+		{
+			Name:        "allow_privilege_escalation",
+			Description: "Whether a process can gain more privileges than its parent process, always true for privileged containers and containers with CAP_SYS_ADMIN.",
+			Type:        schema.TypeBool,
+			Resolver:    resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.AllowPrivilegeEscalation }),
+		},
+		{
+			Name:        "run_as_non_root",
+			Description: "Whether the container must run as a non-root user, merged from the container and pod security contexts.",
+			Type:        schema.TypeBool,
+			Resolver:    resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.RunAsNonRoot }),
+		},
+		{
+			Name:          "run_as_user",
+			Description:   "The UID the container process runs as, merged from the container and pod security contexts. Null if left to the image.",
+			Type:          schema.TypeBigInt,
+			Resolver:      resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.RunAsUser }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "run_as_group",
+			Description:   "The GID the container process runs as, merged from the container and pod security contexts. Null if left to the runtime.",
+			Type:          schema.TypeBigInt,
+			Resolver:      resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.RunAsGroup }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:        "read_only_root_filesystem",
+			Description: "Whether the container has a read-only root filesystem.",
+			Type:        schema.TypeBool,
+			Resolver:    resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.ReadOnlyRootFilesystem }),
+		},
+		{
+			Name:          "capabilities_add",
+			Description:   "Capabilities added to the container on top of the runtime defaults.",
+			Type:          schema.TypeStringArray,
+			Resolver:      resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.CapabilitiesAdd }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "capabilities_drop",
+			Description:   "Capabilities dropped from the runtime defaults.",
+			Type:          schema.TypeStringArray,
+			Resolver:      resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.CapabilitiesDrop }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "seccomp_profile_type",
+			Description:   "Type of the seccomp profile the container runs with, merged from the container and pod security contexts and the legacy seccomp annotations.",
+			Type:          schema.TypeString,
+			Resolver:      resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.SeccompProfileType }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "apparmor_profile",
+			Description:   "AppArmor profile of the container set through the container.apparmor.security.beta.kubernetes.io annotation, e.g. runtime/default.",
+			Type:          schema.TypeString,
+			Resolver:      resolveContainerSecurityContext(s.template, func(s client.SecurityContext) interface{} { return s.AppArmorProfile }),
+			IgnoreInTests: true,
+		},
+		{
+			Name:          "se_linux_options",
+			Description:   "SELinux context applied to the container, merged from the container and pod security contexts.",
+			Type:          schema.TypeJSON,
+			Resolver:      resolveContainerSELinuxOptions(s.template),
+			IgnoreInTests: true,
+		},
+		{
+			Name:        "stdin",
+			Description: "Whether this container should allocate a buffer for stdin in the container runtime",
+			Type:        schema.TypeBool,
+		},
+		{
+			Name:        "stdin_once",
+			Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach",
+			Type:        schema.TypeBool,
+		},
+		{
+			Name:        "tty",
+			Description: "Whether this container should allocate a TTY for itself, also requires 'stdin' to be true.",
+			Type:        schema.TypeBool,
+			Resolver:    schema.PathResolver("TTY"),
+		},
+	}...)
+	return &schema.Table{
+		Name:        name,
+		Description: "A single application container that you want to run within a pod.",
+		Resolver:    s.fetchContainers(init),
+		Columns:     columns,
+		Relations: []*schema.Table{
+			{
+				Name:        singular + "_ports",
+				Description: "ContainerPort represents a network port in a single container.",
+				Resolver:    fetchCorePodContainerPorts,
+				Columns: []schema.Column{
+					parentIDColumn(name),
+					{
+						Name:        "name",
+						Description: "If specified, this must be an IANA_SVC_NAME and unique within the pod",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "host_port",
+						Description: "Number of port to expose on the host.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "container_port",
+						Description: "Number of port to expose on the pod's IP address.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "protocol",
+						Description: "Protocol for port",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "host_ip",
+						Description: "What host IP to bind the external port to.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("HostIP"),
+					},
+				},
+			},
+			{
+				Name:        singular + "_envs",
+				Description: "EnvVar represents an environment variable present in a Container.",
+				Resolver:    fetchCorePodContainerEnvs,
+				Columns: []schema.Column{
+					parentIDColumn(name),
+					{
+						Name:        "name",
+						Description: "Name of the environment variable",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "value",
+						Description: "Variable references $(VAR_NAME) are expanded using the previously defined environment variables in the container and any service environment variables",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "value_from_field_ref_api_version",
+						Description: "Version of the schema the FieldPath is written in terms of, defaults to \"v1\".",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.FieldRef.APIVersion"),
+					},
+					{
+						Name:        "value_from_field_ref_field_path",
+						Description: "Path of the field to select in the specified API version.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.FieldRef.FieldPath"),
+					},
+					{
+						Name:        "value_from_resource_field_ref_container_name",
+						Description: "Container name: required for volumes, optional for env vars",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.ResourceFieldRef.ContainerName"),
+					},
+					{
+						Name:        "value_from_resource_field_ref_resource",
+						Description: "Required: resource to select",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.ResourceFieldRef.Resource"),
+					},
+					{
+						Name:     "value_from_resource_field_ref_divisor_format",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("ValueFrom.ResourceFieldRef.Divisor.Format"),
+					},
+					{
+						Name:        "value_from_config_map_key_ref_local_object_reference_name",
+						Description: "Name of the referent.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.ConfigMapKeyRef.LocalObjectReference.Name"),
+					},
+					{
+						Name:        "value_from_config_map_key_ref_key",
+						Description: "The key to select.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.ConfigMapKeyRef.Key"),
+					},
+					{
+						Name:          "value_from_config_map_key_ref_optional",
+						Description:   "Specify whether the ConfigMap or its key must be defined",
+						Type:          schema.TypeBool,
+						Resolver:      schema.PathResolver("ValueFrom.ConfigMapKeyRef.Optional"),
+						IgnoreInTests: true,
+					},
+					{
+						Name:        "value_from_secret_key_ref_local_object_reference_name",
+						Description: "Name of the referent.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.SecretKeyRef.LocalObjectReference.Name"),
+					},
+					{
+						Name:        "value_from_secret_key_ref_key",
+						Description: "The key of the secret to select from",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ValueFrom.SecretKeyRef.Key"),
+					},
+					{
+						Name:          "value_from_secret_key_ref_optional",
+						Description:   "Specify whether the Secret or its key must be defined.",
+						Type:          schema.TypeBool,
+						Resolver:      schema.PathResolver("ValueFrom.SecretKeyRef.Optional"),
+						IgnoreInTests: true,
+					},
+				},
+			},
+			{
+				Name:        singular + "_volume_mounts",
+				Description: "VolumeMount describes a mounting of a Volume within a container.",
+				Resolver:    fetchCorePodContainerVolumeMounts,
+				Columns: []schema.Column{
+					parentIDColumn(name),
+					{
+						Name:        "name",
+						Description: "This must match the Name of a Volume.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "read_only",
+						Description: "Mounted read-only if true, read-write otherwise (false or unspecified).",
+						Type:        schema.TypeBool,
+					},
+					{
+						Name:        "mount_path",
+						Description: "Path within the container at which the volume should be mounted",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "sub_path",
+						Description: "Path within the volume from which the container's volume should be mounted.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "mount_propagation",
+						Description: "Determines how mounts are propagated from the host to container and the other way around.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "sub_path_expr",
+						Description: "Expanded path within the volume from which the container's volume should be mounted.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			{
+				IgnoreInTests: true,
+				Name:          singular + "_volume_devices",
+				Description:   "volumeDevice describes a mapping of a raw block device within a container.",
+				Resolver:      fetchCorePodContainerVolumeDevices,
+				Columns: []schema.Column{
+					parentIDColumn(name),
+					{
+						Name:        "name",
+						Description: "name must match the name of a persistentVolumeClaim in the pod",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "device_path",
+						Description: "devicePath is the path inside of the container that the device will be mapped to.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// volumesTable returns the table of the volumes of the pod spec.
+func (s podSpec) volumesTable() *schema.Table {
+	return &schema.Table{
+		Name:        s.prefix + "_volumes",
+		Description: "Volume represents a named volume in a pod that may be accessed by any container in the pod.",
+		Resolver:    s.fetchVolumes,
+		Columns: []schema.Column{
+			parentIDColumn(s.prefix + "s"),
+			{
+				Name:        "name",
+				Description: "Volume's name. Must be a DNS_LABEL and unique within the pod.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "host_path",
+				Description: "Pre-existing file or directory on the host machine that is directly exposed to the container.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.HostPath }),
+			},
+			{
+				Name:        "empty_dir",
+				Description: "Temporary directory that shares a pod's lifetime.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.EmptyDir }),
+			},
+			{
+				Name:          "gce_persistent_disk",
+				Description:   "GCE Disk resource that is attached to a kubelet's host machine and then exposed to the pod.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.GCEPersistentDisk }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "aws_elastic_block_store",
+				Description:   "AWS Disk resource that is attached to a kubelet's host machine and then exposed to the pod.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.AWSElasticBlockStore }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "secret",
+				Description:   "A secret that should populate this volume.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Secret }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "nfs",
+				Description:   "NFS mount on the host that shares a pod's lifetime",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.NFS }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "iscsi",
+				Description:   "ISCSI represents an ISCSI Disk resource that is attached to a kubelet's host machine and then exposed to the pod.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.ISCSI }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "glusterfs",
+				Description:   "Glusterfs mount on the host that shares a pod's lifetime.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Glusterfs }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "persistent_volume_claim",
+				Description:   "Persistent volume claim.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.PersistentVolumeClaim }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "rbd",
+				Description:   "Rados Block Device mount on the host that shares a pod's lifetime.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.RBD }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "flex_volume",
+				Description:   "Generic volume resource that is provisioned/attached using an exec based plugin.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.FlexVolume }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "cinder",
+				Description:   "Cinder volume attached and mounted on kubelets host machine.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Cinder }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "ceph_fs",
+				Description:   "Ceph FS mount on the host that shares a pod's lifetime.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.CephFS }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "flocker",
+				Description:   "Flocker volume attached to a kubelet's host machine.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Flocker }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "downward_api",
+				Description:   "Optional: mode bits to use on created files by default",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.DownwardAPI }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "fc",
+				Description:   "Fibre Channel resource that is attached to a kubelet's host machine.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.FC }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "azure_file",
+				Description:   "Azure File Service mount on the host and bind mount to the pod.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.AzureFile }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "config_map",
+				Description: "configMap that should populate this volume",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.ConfigMap }),
+			},
+			{
+				Name:          "vsphere_volume",
+				Description:   "vSphere volume attached and mounted on kubelets host machine.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.VsphereVolume }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "quobyte",
+				Description:   "Quobyte mount on the host that shares a pod's lifetime.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Quobyte }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "azure_disk",
+				Description:   "The Name of the data disk in the blob storage",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.AzureDisk }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "photon_persistent_disk",
+				Description:   "PhotonController persistent disk attached and mounted on kubelets host machine.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.PhotonPersistentDisk }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "projected",
+				Description: "Items for all in one resources secrets, configmaps, and downward API.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Projected }),
+			},
+			{
+				Name:          "portworx_volume",
+				Description:   "Portworx volume attached and mounted on kubelets host machine.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.PortworxVolume }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "scale_io",
+				Description:   "ScaleIO persistent volume attached and mounted on Kubernetes nodes.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.ScaleIO }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "storage_os",
+				Description:   "StorageOS represents a StorageOS volume attached and mounted on Kubernetes nodes.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.StorageOS }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "csi",
+				Description:   "CSI (Container Storage Interface) represents ephemeral storage that is handled by certain external CSI drivers (Beta feature).",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.CSI }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "ephemeral",
+				Description:   "Ephemeral represents a volume that is handled by a cluster storage driver.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Ephemeral }),
+				IgnoreInTests: true,
+			},
+		},
+	}
+}
+
+// parentIDColumn returns the foreign key column referencing the given parent table.
+func parentIDColumn(table string) schema.Column {
+	singular := strings.TrimSuffix(table, "s")
+	return schema.Column{
+		Name:        strings.SplitN(singular, "_", 3)[2] + "_cq_id",
+		Description: "Unique CloudQuery ID of " + table + " table (FK)",
+		Type:        schema.TypeUUID,
+		Resolver:    schema.ParentIdResolver,
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func (s podSpec) fetchContainers(init bool) schema.TableResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+		spec := s.template(parent.Item).Spec
+		if init {
+			res <- spec.InitContainers
+		} else {
+			res <- spec.Containers
+		}
+		return nil
+	}
+}
+
+func (s podSpec) fetchVolumes(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	res <- s.template(parent.Item).Spec.Volumes
+	return nil
+}
+
+func resolveContainerJSONField(fieldResolver func(c corev1.Container) interface{}) func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		cont := resource.Item.(corev1.Container)
+		b, err := json.Marshal(fieldResolver(cont))
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, b))
+	}
+}
+
+func fetchCorePodContainerPorts(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cont := parent.Item.(corev1.Container)
+	res <- cont.Ports
+	return nil
+}
+
+func fetchCorePodContainerEnvs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cont := parent.Item.(corev1.Container)
+	res <- cont.Env
+	return nil
+}
+
+func fetchCorePodContainerVolumeMounts(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cont := parent.Item.(corev1.Container)
+	res <- cont.VolumeMounts
+	return nil
+}
+
+func fetchCorePodContainerVolumeDevices(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cont := parent.Item.(corev1.Container)
+	res <- cont.VolumeDevices
+	return nil
+}
+
+func resolveVolumeJSONField(fieldResolver func(v corev1.Volume) interface{}) func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		vol := resource.Item.(corev1.Volume)
+		b, err := json.Marshal(fieldResolver(vol))
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, b))
+	}
+}
+
+// resolveContainerSecurityContext resolves a field of the security context a container effectively runs with, i.e.
+// its own security context merged with the one of the pod template of its parent.
+func resolveContainerSecurityContext(template func(item interface{}) corev1.PodTemplateSpec, field func(s client.SecurityContext) interface{}) schema.ColumnResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		return diag.WrapError(resource.Set(c.Name, nilIfEmpty(field(containerSecurityContext(template, resource)))))
+	}
+}
+
+func resolveContainerSELinuxOptions(template func(item interface{}) corev1.PodTemplateSpec) schema.ColumnResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		opts := containerSecurityContext(template, resource).SELinuxOptions
+		if opts == nil {
+			return nil
+		}
+		b, err := json.Marshal(opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, b))
+	}
+}
+
+func containerSecurityContext(template func(item interface{}) corev1.PodTemplateSpec, resource *schema.Resource) client.SecurityContext {
+	t := template(resource.Parent.Item)
+	name, _ := funk.Get(resource.Item, "Name").(string)
+	sc, _ := funk.Get(resource.Item, "SecurityContext").(*corev1.SecurityContext)
+	return client.EffectiveSecurityContext(corev1.Pod{ObjectMeta: t.ObjectMeta, Spec: t.Spec}, name, sc)
+}
@@ -0,0 +1,38 @@
+package core
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParentIDColumn(t *testing.T) {
+	cases := map[string]string{
+		"k8s_core_pods":                    "pod_cq_id",
+		"k8s_batch_cron_jobs":              "cron_job_cq_id",
+		"k8s_apps_daemon_sets":             "daemon_set_cq_id",
+		"k8s_core_pod_init_containers":     "pod_init_container_cq_id",
+		"k8s_apps_deployment_containers":   "deployment_container_cq_id",
+		"k8s_core_replication_controllers": "replication_controller_cq_id",
+		"k8s_core_pod_template_containers": "pod_template_container_cq_id",
+	}
+	for table, want := range cases {
+		if got := parentIDColumn(table).Name; got != want {
+			t.Errorf("parentIDColumn(%q) = %q, want %q", table, got, want)
+		}
+	}
+}
+
+func TestPodTemplateTablesParentIDColumns(t *testing.T) {
+	tables := PodTemplateTables("k8s_batch_cron_job", func(interface{}) corev1.PodTemplateSpec { return corev1.PodTemplateSpec{} })
+	for _, table := range tables {
+		if got := table.Columns[0].Name; got != "cron_job_cq_id" {
+			t.Errorf("%s: expected cron_job_cq_id, got %q", table.Name, got)
+		}
+	}
+	for _, rel := range tables[0].Relations {
+		if got := rel.Columns[0].Name; got != "cron_job_init_container_cq_id" {
+			t.Errorf("%s: expected cron_job_init_container_cq_id, got %q", rel.Name, got)
+		}
+	}
+}
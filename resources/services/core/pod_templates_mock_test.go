@@ -0,0 +1,37 @@
+//go:build mock
+// +build mock
+
+package core
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createPodTemplates(t *testing.T, ctrl *gomock.Controller) client.Services {
+	templates := mocks.NewMockPodTemplatesClient(ctrl)
+	templates.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.PodTemplateList{Items: []corev1.PodTemplate{fakePodTemplate(t)}}, nil,
+	)
+	return client.Services{
+		PodTemplates: templates,
+	}
+}
+
+func fakePodTemplate(t *testing.T) corev1.PodTemplate {
+	var pt corev1.PodTemplate
+	k8sTesting.FakeThroughPointers(t, &pt.TypeMeta, &pt.ObjectMeta)
+	pt.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	pt.Template = k8sTesting.FakePodTemplateSpec(t)
+	return pt
+}
+
+func TestPodTemplates(t *testing.T) {
+	client.K8sMockTestHelper(t, PodTemplates(), createPodTemplates, client.TestOptions{})
+}
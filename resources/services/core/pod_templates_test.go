@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package core
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationPodTemplates(t *testing.T) {
+	client.K8sTestHelper(t, PodTemplates(), "./snapshots")
+}
@@ -8,10 +8,14 @@ import (
 	"github.com/cloudquery/cq-provider-k8s/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/thoas/go-funk"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// corePodSpec builds the container and volume tables of k8s_core_pods.
+var corePodSpec = podSpec{prefix: "k8s_core_pod", template: podTemplate, pod: true}
+
 func Pods() *schema.Table {
 	return &schema.Table{
 		Name:         "k8s_core_pods",
@@ -90,6 +94,27 @@ func Pods() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    resolveCorePodOwnerReferences,
 			},
+			{
+				Name:          "root_owner_kind",
+				Description:   "Kind of the top-level owner, found by following the managing controllers in the owner references, e.g. Deployment for pods of a replica set or an unknown custom resource kind such as Rollout.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return ref.Kind }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "root_owner_name",
+				Description:   "Name of the top-level owner.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return ref.Name }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "root_owner_uid",
+				Description:   "UID of the top-level owner.",
+				Type:          schema.TypeString,
+				Resolver:      client.RootOwnerResolver(func(ref metav1.OwnerReference) interface{} { return string(ref.UID) }),
+				IgnoreInTests: true,
+			},
 			{
 				Name:          "finalizers",
 				Description:   "List of finalizers",
@@ -182,6 +207,46 @@ func Pods() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    resolveCorePodSecurityContext,
 			},
+			{
+				Name:        "run_as_non_root",
+				Description: "Whether containers must run as a non-root user unless they override it.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveCorePodSecurityContextField(func(s client.SecurityContext) interface{} { return s.RunAsNonRoot }),
+			},
+			{
+				Name:          "run_as_user",
+				Description:   "The UID container processes run as unless they override it.",
+				Type:          schema.TypeBigInt,
+				Resolver:      resolveCorePodSecurityContextField(func(s client.SecurityContext) interface{} { return s.RunAsUser }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "run_as_group",
+				Description:   "The GID container processes run as unless they override it.",
+				Type:          schema.TypeBigInt,
+				Resolver:      resolveCorePodSecurityContextField(func(s client.SecurityContext) interface{} { return s.RunAsGroup }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "seccomp_profile_type",
+				Description:   "Type of the seccomp profile containers run with unless they override it, including the legacy seccomp.security.alpha.kubernetes.io/pod annotation.",
+				Type:          schema.TypeString,
+				Resolver:      resolveCorePodSecurityContextField(func(s client.SecurityContext) interface{} { return s.SeccompProfileType }),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "se_linux_options",
+				Description:   "SELinux context applied to containers unless they override it.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveCorePodSELinuxOptions,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "pss_max_level",
+				Description: "Most restrictive level of the Pod Security Standards the pod satisfies, evaluated against the version enforced in its namespace: privileged, baseline or restricted.",
+				Type:        schema.TypeString,
+				Resolver:    resolveCorePodPSSMaxLevel,
+			},
 			{
 				Name:          "image_pull_secrets",
 				Description:   "Optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec.",
@@ -347,12 +412,19 @@ func Pods() *schema.Table {
 			},
 		},
 		Relations: []*schema.Table{
+			corePodSpec.containersTable(true),
+			corePodSpec.containersTable(false),
 			{
 				IgnoreInTests: true,
-				Name:          "k8s_core_pod_init_containers",
-				Description:   "A single application container that you want to run within a pod.",
-				Resolver:      fetchCorePodInitContainers,
+				Name:          "k8s_core_pod_ephemeral_containers",
+				Description:   "An EphemeralContainer is a container that may be added temporarily to an existing pod for user-initiated activities such as debugging",
+				Resolver:      fetchCorePodEphemeralContainers,
 				Columns: []schema.Column{
+					{
+						Name:        "target_container_name",
+						Description: "If set, the name of the container from PodSpec that this ephemeral container targets.",
+						Type:        schema.TypeString,
+					},
 					{
 						Name:        "pod_cq_id",
 						Description: "Unique CloudQuery ID of k8s_core_pods table (FK)",
@@ -363,653 +435,52 @@ func Pods() *schema.Table {
 						Name:        "name",
 						Description: "Name of the container specified as a DNS_LABEL.",
 						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("EphemeralContainerCommon.Name"),
 					},
 					{
 						Name:        "image",
 						Description: "Docker image name.",
 						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("EphemeralContainerCommon.Image"),
 					},
 					{
-						Name:        "command",
-						Description: "Entrypoint array",
-						Type:        schema.TypeStringArray,
-					},
-					{
-						Name:        "args",
-						Description: "Arguments to the entrypoint.",
-						Type:        schema.TypeStringArray,
-					},
-					{
-						Name:        "working_dir",
-						Description: "Container's working directory.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "env_from",
-						Description: "List of sources to populate environment variables in the container.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.EnvFrom }),
-					},
-					{
-						Name:        "resources_limits",
-						Description: "Limits describes the maximum amount of compute resources allowed.",
-						Type:        schema.TypeJSON,
-						Resolver:    schema.PathResolver("Resources.Limits"),
-					},
-					{
-						Name:        "resources_requests",
-						Description: "Requests describes the minimum amount of compute resources required.",
-						Type:        schema.TypeJSON,
-						Resolver:    schema.PathResolver("Resources.Requests"),
-					},
-					{
-						Name:        "liveness_probe",
-						Description: "Periodic probe of container liveness.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.LivenessProbe }),
-					},
-					{
-						Name:        "readiness_probe",
-						Description: "Periodic probe of container service readiness.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.ReadinessProbe }),
-					},
-					{
-						Name:        "startup_probe",
-						Description: "Startup probe indicates that the Pod has successfully initialized.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.ReadinessProbe }),
-					},
-					{
-						Name:        "lifecycle",
-						Description: "Actions that the management system should take in response to container lifecycle events.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.Lifecycle }),
-					},
-					{
-						Name:        "termination_message_path",
-						Description: "Path at which the file to which the container's termination message will be written is mounted into the container's filesystem.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "termination_message_policy",
-						Description: "Indicate how the termination message should be populated.",
+						Name:        "image_registry",
+						Description: "Registry the image is pulled from, docker.io if the image name has no registry.",
 						Type:        schema.TypeString,
+						Resolver:    client.ImageReferenceResolver("EphemeralContainerCommon.Image", func(r client.ImageReference) string { return r.Registry }),
 					},
 					{
-						Name:        "image_pull_policy",
-						Description: "Image pull policy.",
+						Name:        "image_repository",
+						Description: "Repository of the image in its registry, e.g. library/nginx.",
 						Type:        schema.TypeString,
+						Resolver:    client.ImageReferenceResolver("EphemeralContainerCommon.Image", func(r client.ImageReference) string { return r.Repository }),
 					},
 					{
-						Name:        "security_context",
-						Description: "security options the container should be run with.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.SecurityContext }),
-					},
-					{
-						Name:        "stdin",
-						Description: "Whether this container should allocate a buffer for stdin in the container runtime",
-						Type:        schema.TypeBool,
-					},
-					{
-						Name:        "stdin_once",
-						Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach",
-						Type:        schema.TypeBool,
-					},
-					{
-						Name:        "tty",
-						Description: "Whether this container should allocate a TTY for itself, also requires 'stdin' to be true. Default is false.",
-						Type:        schema.TypeBool,
-						Resolver:    schema.PathResolver("TTY"),
-					},
-				},
-				Relations: []*schema.Table{
-					{
+						Name:          "image_tag",
+						Description:   "Tag of the image, latest if the image is referenced by neither a tag nor a digest.",
+						Type:          schema.TypeString,
+						Resolver:      client.ImageReferenceResolver("EphemeralContainerCommon.Image", func(r client.ImageReference) string { return r.Tag }),
 						IgnoreInTests: true,
-						Name:          "k8s_core_pod_init_container_ports",
-						Description:   "ContainerPort represents a network port in a single container.",
-						Resolver:      fetchCorePodContainerPorts,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_init_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "If specified, this must be an IANA_SVC_NAME and unique within the pod",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "host_port",
-								Description: "Number of port to expose on the host.",
-								Type:        schema.TypeInt,
-							},
-							{
-								Name:        "container_port",
-								Description: "Number of port to expose on the pod's IP address.",
-								Type:        schema.TypeInt,
-							},
-							{
-								Name:        "protocol",
-								Description: "Protocol for port",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "host_ip",
-								Description: "What host IP to bind the external port to.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("HostIP"),
-							},
-						},
 					},
 					{
+						Name:          "image_digest",
+						Description:   "Digest the image is pinned to, e.g. sha256:4ed64c2e.",
+						Type:          schema.TypeString,
+						Resolver:      client.ImageReferenceResolver("EphemeralContainerCommon.Image", func(r client.ImageReference) string { return r.Digest }),
 						IgnoreInTests: true,
-						Name:          "k8s_core_pod_init_container_envs",
-						Description:   "EnvVar represents an environment variable present in a Container.",
-						Resolver:      fetchCorePodContainerEnvs,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_init_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "Name of the environment variable",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "value",
-								Description: "Variable references $(VAR_NAME) are expanded using the previously defined environment variables in the container and any service environment variables",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "value_from_field_ref_api_version",
-								Description: "Version of the schema the FieldPath is written in terms of.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.FieldRef.APIVersion"),
-							},
-							{
-								Name:        "value_from_field_ref_field_path",
-								Description: "Path of the field to select in the specified API version.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.FieldRef.FieldPath"),
-							},
-							{
-								Name:        "value_from_resource_field_ref_container_name",
-								Description: "Container name: required for volumes, optional for env vars.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ResourceFieldRef.ContainerName"),
-							},
-							{
-								Name:        "value_from_resource_field_ref_resource",
-								Description: "Required: resource to select",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ResourceFieldRef.Resource"),
-							},
-							{
-								Name:     "value_from_resource_field_ref_divisor_format",
-								Type:     schema.TypeString,
-								Resolver: schema.PathResolver("ValueFrom.ResourceFieldRef.Divisor.Format"),
-							},
-							{
-								Name:        "value_from_config_map_key_ref_local_object_reference_name",
-								Description: "Name of the referent.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ConfigMapKeyRef.LocalObjectReference.Name"),
-							},
-							{
-								Name:        "value_from_config_map_key_ref_key",
-								Description: "The key to select.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ConfigMapKeyRef.Key"),
-							},
-							{
-								Name:          "value_from_config_map_key_ref_optional",
-								Description:   "Specify whether the ConfigMap or its key must be defined.",
-								Type:          schema.TypeBool,
-								Resolver:      schema.PathResolver("ValueFrom.ConfigMapKeyRef.Optional"),
-								IgnoreInTests: true,
-							},
-							{
-								Name:        "value_from_secret_key_ref_local_object_reference_name",
-								Description: "Name of the referent.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.SecretKeyRef.LocalObjectReference.Name"),
-							},
-							{
-								Name:        "value_from_secret_key_ref_key",
-								Description: "The key of the secret to select from",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.SecretKeyRef.Key"),
-							},
-							{
-								Name:        "value_from_secret_key_ref_optional",
-								Description: "Specify whether the Secret or its key must be defined",
-								Type:        schema.TypeBool,
-								Resolver:    schema.PathResolver("ValueFrom.SecretKeyRef.Optional"),
-							},
-						},
 					},
-					{
-						IgnoreInTests: true,
-						Name:          "k8s_core_pod_init_container_volume_mounts",
-						Description:   "VolumeMount describes a mounting of a Volume within a container.",
-						Resolver:      fetchCorePodContainerVolumeMounts,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_init_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "This must match the Name of a Volume.",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "read_only",
-								Description: "Mounted read-only if true, read-write otherwise (false or unspecified).",
-								Type:        schema.TypeBool,
-							},
-							{
-								Name:        "mount_path",
-								Description: "Path within the container at which the volume should be mounted",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "sub_path",
-								Description: "Path within the volume from which the container's volume should be mounted.",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "mount_propagation",
-								Description: "Determines how mounts are propagated from the host to container and the other way around.",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "sub_path_expr",
-								Description: "Expanded path within the volume from which the container's volume should be mounted.",
-								Type:        schema.TypeString,
-							},
-						},
-					},
-					{
-						IgnoreInTests: true,
-						Name:          "k8s_core_pod_init_container_volume_devices",
-						Description:   "volumeDevice describes a mapping of a raw block device within a container.",
-						Resolver:      fetchCorePodContainerVolumeDevices,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_init_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "name must match the name of a persistentVolumeClaim in the pod",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "device_path",
-								Description: "devicePath is the path inside of the container that the device will be mapped to.",
-								Type:        schema.TypeString,
-							},
-						},
-					},
-				},
-			},
-			{
-				Name:        "k8s_core_pod_containers",
-				Description: "A single application container that you want to run within a pod.",
-				Resolver:    fetchCorePodContainers,
-				Columns: []schema.Column{
-					{
-						Name:        "pod_cq_id",
-						Description: "Unique CloudQuery ID of k8s_core_pods table (FK)",
-						Type:        schema.TypeUUID,
-						Resolver:    schema.ParentIdResolver,
-					},
-					{
-						Name:        "name",
-						Description: "Name of the container specified as a DNS_LABEL.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "image",
-						Description: "Docker image name.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "command",
-						Description: "Entrypoint array",
-						Type:        schema.TypeStringArray,
-					},
-					{
-						Name:        "args",
-						Description: "Arguments to the entrypoint.",
-						Type:        schema.TypeStringArray,
-					},
-					{
-						Name:        "working_dir",
-						Description: "Container's working directory.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:          "env_from",
-						Description:   "List of sources to populate environment variables in the container.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveContainerJSONField(func(c corev1.Container) interface{} { return c.EnvFrom }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:        "resources_limits",
-						Description: "Limits describes the maximum amount of compute resources allowed.",
-						Type:        schema.TypeJSON,
-						Resolver:    schema.PathResolver("Resources.Limits"),
-					},
-					{
-						Name:        "resources_requests",
-						Description: "Requests describes the minimum amount of compute resources required.",
-						Type:        schema.TypeJSON,
-						Resolver:    schema.PathResolver("Resources.Requests"),
-					},
-					{
-						Name:        "liveness_probe",
-						Description: "Periodic probe of container liveness.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.LivenessProbe }),
-					},
-					{
-						Name:        "readiness_probe",
-						Description: "Periodic probe of container service readiness.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.ReadinessProbe }),
-					},
-					{
-						Name:        "startup_probe",
-						Description: "Startup probe indicates that the Pod has successfully initialized.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.StartupProbe }),
-					},
-					{
-						Name:          "lifecycle",
-						Description:   "Actions that the management system should take in response to container lifecycle events.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveContainerJSONField(func(c corev1.Container) interface{} { return c.Lifecycle }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:        "termination_message_path",
-						Description: "Path at which the file to which the container's termination message will be written is mounted into the container's filesystem.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "termination_message_policy",
-						Description: "Indicate how the termination message should be populated.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "image_pull_policy",
-						Description: "Image pull policy.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "security_context",
-						Description: "security options the container should be run with.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveContainerJSONField(func(c corev1.Container) interface{} { return c.SecurityContext }),
-					},
-					{
-						Name:        "stdin",
-						Description: "Whether this container should allocate a buffer for stdin in the container runtime",
-						Type:        schema.TypeBool,
-					},
-					{
-						Name:        "stdin_once",
-						Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach",
-						Type:        schema.TypeBool,
-					},
-					{
-						Name:        "tty",
-						Description: "Whether this container should allocate a TTY for itself, also requires 'stdin' to be true.",
-						Type:        schema.TypeBool,
-						Resolver:    schema.PathResolver("TTY"),
-					},
-				},
-				Relations: []*schema.Table{
-					{
-						Name:        "k8s_core_pod_container_ports",
-						Description: "ContainerPort represents a network port in a single container.",
-						Resolver:    fetchCorePodContainerPorts,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "If specified, this must be an IANA_SVC_NAME and unique within the pod",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "host_port",
-								Description: "Number of port to expose on the host.",
-								Type:        schema.TypeInt,
-							},
-							{
-								Name:        "container_port",
-								Description: "Number of port to expose on the pod's IP address.",
-								Type:        schema.TypeInt,
-							},
-							{
-								Name:        "protocol",
-								Description: "Protocol for port",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "host_ip",
-								Description: "What host IP to bind the external port to.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("HostIP"),
-							},
-						},
-					},
-					{
-						Name:        "k8s_core_pod_container_envs",
-						Description: "EnvVar represents an environment variable present in a Container.",
-						Resolver:    fetchCorePodContainerEnvs,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "Name of the environment variable",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "value",
-								Description: "Variable references $(VAR_NAME) are expanded using the previously defined environment variables in the container and any service environment variables",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "value_from_field_ref_api_version",
-								Description: "Version of the schema the FieldPath is written in terms of, defaults to \"v1\".",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.FieldRef.APIVersion"),
-							},
-							{
-								Name:        "value_from_field_ref_field_path",
-								Description: "Path of the field to select in the specified API version.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.FieldRef.FieldPath"),
-							},
-							{
-								Name:        "value_from_resource_field_ref_container_name",
-								Description: "Container name: required for volumes, optional for env vars",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ResourceFieldRef.ContainerName"),
-							},
-							{
-								Name:        "value_from_resource_field_ref_resource",
-								Description: "Required: resource to select",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ResourceFieldRef.Resource"),
-							},
-							{
-								Name:     "value_from_resource_field_ref_divisor_format",
-								Type:     schema.TypeString,
-								Resolver: schema.PathResolver("ValueFrom.ResourceFieldRef.Divisor.Format"),
-							},
-							{
-								Name:        "value_from_config_map_key_ref_local_object_reference_name",
-								Description: "Name of the referent.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ConfigMapKeyRef.LocalObjectReference.Name"),
-							},
-							{
-								Name:        "value_from_config_map_key_ref_key",
-								Description: "The key to select.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.ConfigMapKeyRef.Key"),
-							},
-							{
-								Name:          "value_from_config_map_key_ref_optional",
-								Description:   "Specify whether the ConfigMap or its key must be defined",
-								Type:          schema.TypeBool,
-								Resolver:      schema.PathResolver("ValueFrom.ConfigMapKeyRef.Optional"),
-								IgnoreInTests: true,
-							},
-							{
-								Name:        "value_from_secret_key_ref_local_object_reference_name",
-								Description: "Name of the referent.",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.SecretKeyRef.LocalObjectReference.Name"),
-							},
-							{
-								Name:        "value_from_secret_key_ref_key",
-								Description: "The key of the secret to select from",
-								Type:        schema.TypeString,
-								Resolver:    schema.PathResolver("ValueFrom.SecretKeyRef.Key"),
-							},
-							{
-								Name:          "value_from_secret_key_ref_optional",
-								Description:   "Specify whether the Secret or its key must be defined.",
-								Type:          schema.TypeBool,
-								Resolver:      schema.PathResolver("ValueFrom.SecretKeyRef.Optional"),
-								IgnoreInTests: true,
-							},
-						},
-					},
-					{
-						Name:        "k8s_core_pod_container_volume_mounts",
-						Description: "VolumeMount describes a mounting of a Volume within a container.",
-						Resolver:    fetchCorePodContainerVolumeMounts,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "This must match the Name of a Volume.",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "read_only",
-								Description: "Mounted read-only if true, read-write otherwise (false or unspecified).",
-								Type:        schema.TypeBool,
-							},
-							{
-								Name:        "mount_path",
-								Description: "Path within the container at which the volume should be mounted",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "sub_path",
-								Description: "Path within the volume from which the container's volume should be mounted.",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "mount_propagation",
-								Description: "Determines how mounts are propagated from the host to container and the other way around.",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "sub_path_expr",
-								Description: "Expanded path within the volume from which the container's volume should be mounted.",
-								Type:        schema.TypeString,
-							},
-						},
-					},
-					{
-						IgnoreInTests: true,
-						Name:          "k8s_core_pod_container_volume_devices",
-						Description:   "volumeDevice describes a mapping of a raw block device within a container.",
-						Resolver:      fetchCorePodContainerVolumeDevices,
-						Columns: []schema.Column{
-							{
-								Name:        "pod_container_cq_id",
-								Description: "Unique CloudQuery ID of k8s_core_pod_init_containers table (FK)",
-								Type:        schema.TypeUUID,
-								Resolver:    schema.ParentIdResolver,
-							},
-							{
-								Name:        "name",
-								Description: "name must match the name of a persistentVolumeClaim in the pod",
-								Type:        schema.TypeString,
-							},
-							{
-								Name:        "device_path",
-								Description: "devicePath is the path inside of the container that the device will be mapped to.",
-								Type:        schema.TypeString,
-							},
-						},
-					},
-				},
-			},
-			{
-				IgnoreInTests: true,
-				Name:          "k8s_core_pod_ephemeral_containers",
-				Description:   "An EphemeralContainer is a container that may be added temporarily to an existing pod for user-initiated activities such as debugging",
-				Resolver:      fetchCorePodEphemeralContainers,
-				Columns: []schema.Column{
-					{
-						Name:        "target_container_name",
-						Description: "If set, the name of the container from PodSpec that this ephemeral container targets.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "pod_cq_id",
-						Description: "Unique CloudQuery ID of k8s_core_pods table (FK)",
-						Type:        schema.TypeUUID,
-						Resolver:    schema.ParentIdResolver,
-					},
-					{
-						Name:        "name",
-						Description: "Name of the container specified as a DNS_LABEL.",
-						Type:        schema.TypeString,
-						Resolver:    schema.PathResolver("EphemeralContainerCommon.Name"),
+					{
+						Name:        "image_pinned_by_digest",
+						Description: "Whether the image is referenced by digest, so that the same image is always run.",
+						Type:        schema.TypeBool,
+						Resolver:    client.ImagePinnedByDigestResolver("EphemeralContainerCommon.Image"),
 					},
 					{
-						Name:        "image",
-						Description: "Docker image name.",
-						Type:        schema.TypeString,
-						Resolver:    schema.PathResolver("EphemeralContainerCommon.Image"),
+						Name:          "image_id",
+						Description:   "Digest of the image the container runs, resolved by the container runtime and reported in the container status.",
+						Type:          schema.TypeString,
+						Resolver:      resolvePodContainerImageID(func(s corev1.PodStatus) []corev1.ContainerStatus { return s.EphemeralContainerStatuses }),
+						IgnoreInTests: true,
 					},
 					{
 						Name:        "command",
@@ -1047,6 +518,58 @@ func Pods() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    schema.PathResolver("EphemeralContainerCommon.Resources.Requests"),
 					},
+					{
+						Name:        "cpu_request_millicores",
+						Description: "CPU requested by the container, in millicores.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListMilliValueResolver("Resources.Requests", corev1.ResourceCPU),
+					},
+					{
+						Name:        "cpu_limit_millicores",
+						Description: "CPU limit of the container, in millicores.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListMilliValueResolver("Resources.Limits", corev1.ResourceCPU),
+					},
+					{
+						Name:        "memory_request_bytes",
+						Description: "Memory requested by the container, in bytes.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListValueResolver("Resources.Requests", corev1.ResourceMemory),
+					},
+					{
+						Name:        "memory_limit_bytes",
+						Description: "Memory limit of the container, in bytes.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListValueResolver("Resources.Limits", corev1.ResourceMemory),
+					},
+					{
+						Name:          "ephemeral_storage_request_bytes",
+						Description:   "Ephemeral storage requested by the container, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("Resources.Requests", corev1.ResourceEphemeralStorage),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "ephemeral_storage_limit_bytes",
+						Description:   "Ephemeral storage limit of the container, in bytes.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListValueResolver("Resources.Limits", corev1.ResourceEphemeralStorage),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "gpu_request",
+						Description:   "GPU requested by the container, summed over the GPU extended resources of every vendor.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListGPUResolver("Resources.Requests", ""),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "gpu_limit",
+						Description:   "GPU limit of the container, summed over the GPU extended resources of every vendor.",
+						Type:          schema.TypeBigInt,
+						Resolver:      client.ResourceListGPUResolver("Resources.Limits", ""),
+						IgnoreInTests: true,
+					},
 					{
 						Name:        "liveness_probe",
 						Description: "Periodic probe of container liveness.",
@@ -1095,6 +618,79 @@ func Pods() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    resolveEphemeralContainerJSONField(func(c corev1.EphemeralContainer) interface{} { return c.EphemeralContainerCommon.SecurityContext }),
 					},
+					{
+						Name:        "privileged",
+						Description: "Whether the container runs in privileged mode, equivalent to root on the host.",
+						Type:        schema.TypeBool,
+						Resolver:    resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.Privileged }),
+					},
+					{
+						Name:        "allow_privilege_escalation",
+						Description: "Whether a process can gain more privileges than its parent process, always true for privileged containers and containers with CAP_SYS_ADMIN.",
+						Type:        schema.TypeBool,
+						Resolver:    resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.AllowPrivilegeEscalation }),
+					},
+					{
+						Name:        "run_as_non_root",
+						Description: "Whether the container must run as a non-root user, merged from the container and pod security contexts.",
+						Type:        schema.TypeBool,
+						Resolver:    resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.RunAsNonRoot }),
+					},
+					{
+						Name:          "run_as_user",
+						Description:   "The UID the container process runs as, merged from the container and pod security contexts. Null if left to the image.",
+						Type:          schema.TypeBigInt,
+						Resolver:      resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.RunAsUser }),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "run_as_group",
+						Description:   "The GID the container process runs as, merged from the container and pod security contexts. Null if left to the runtime.",
+						Type:          schema.TypeBigInt,
+						Resolver:      resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.RunAsGroup }),
+						IgnoreInTests: true,
+					},
+					{
+						Name:        "read_only_root_filesystem",
+						Description: "Whether the container has a read-only root filesystem.",
+						Type:        schema.TypeBool,
+						Resolver:    resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.ReadOnlyRootFilesystem }),
+					},
+					{
+						Name:          "capabilities_add",
+						Description:   "Capabilities added to the container on top of the runtime defaults.",
+						Type:          schema.TypeStringArray,
+						Resolver:      resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.CapabilitiesAdd }),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "capabilities_drop",
+						Description:   "Capabilities dropped from the runtime defaults.",
+						Type:          schema.TypeStringArray,
+						Resolver:      resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.CapabilitiesDrop }),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "seccomp_profile_type",
+						Description:   "Type of the seccomp profile the container runs with, merged from the container and pod security contexts and the legacy seccomp annotations.",
+						Type:          schema.TypeString,
+						Resolver:      resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.SeccompProfileType }),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "apparmor_profile",
+						Description:   "AppArmor profile of the container set through the container.apparmor.security.beta.kubernetes.io annotation, e.g. runtime/default.",
+						Type:          schema.TypeString,
+						Resolver:      resolveContainerSecurityContext(podTemplate, func(s client.SecurityContext) interface{} { return s.AppArmorProfile }),
+						IgnoreInTests: true,
+					},
+					{
+						Name:          "se_linux_options",
+						Description:   "SELinux context applied to the container, merged from the container and pod security contexts.",
+						Type:          schema.TypeJSON,
+						Resolver:      resolveContainerSELinuxOptions(podTemplate),
+						IgnoreInTests: true,
+					},
 					{
 						Name:        "stdin",
 						Description: "Whether this container should allocate a buffer for stdin in the container runtime",
@@ -1314,216 +910,7 @@ func Pods() *schema.Table {
 					},
 				},
 			},
-			{
-				Name:        "k8s_core_pod_volumes",
-				Description: "Volume represents a named volume in a pod that may be accessed by any container in the pod.",
-				Resolver:    fetchCorePodVolumes,
-				Columns: []schema.Column{
-					{
-						Name:        "pod_cq_id",
-						Description: "Unique CloudQuery ID of k8s_core_pods table (FK)",
-						Type:        schema.TypeUUID,
-						Resolver:    schema.ParentIdResolver,
-					},
-					{
-						Name:        "name",
-						Description: "Volume's name. Must be a DNS_LABEL and unique within the pod.",
-						Type:        schema.TypeString,
-					},
-					{
-						Name:        "host_path",
-						Description: "Pre-existing file or directory on the host machine that is directly exposed to the container.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.HostPath }),
-					},
-					{
-						Name:        "empty_dir",
-						Description: "Temporary directory that shares a pod's lifetime.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.EmptyDir }),
-					},
-					{
-						Name:          "gce_persistent_disk",
-						Description:   "GCE Disk resource that is attached to a kubelet's host machine and then exposed to the pod.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.GCEPersistentDisk }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "aws_elastic_block_store",
-						Description:   "AWS Disk resource that is attached to a kubelet's host machine and then exposed to the pod.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.AWSElasticBlockStore }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "secret",
-						Description:   "A secret that should populate this volume.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Secret }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "nfs",
-						Description:   "NFS mount on the host that shares a pod's lifetime",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.NFS }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "iscsi",
-						Description:   "ISCSI represents an ISCSI Disk resource that is attached to a kubelet's host machine and then exposed to the pod.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.ISCSI }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "glusterfs",
-						Description:   "Glusterfs mount on the host that shares a pod's lifetime.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Glusterfs }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "persistent_volume_claim",
-						Description:   "Persistent volume claim.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.PersistentVolumeClaim }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "rbd",
-						Description:   "Rados Block Device mount on the host that shares a pod's lifetime.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.RBD }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "flex_volume",
-						Description:   "Generic volume resource that is provisioned/attached using an exec based plugin.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.FlexVolume }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "cinder",
-						Description:   "Cinder volume attached and mounted on kubelets host machine.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Cinder }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "ceph_fs",
-						Description:   "Ceph FS mount on the host that shares a pod's lifetime.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.CephFS }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "flocker",
-						Description:   "Flocker volume attached to a kubelet's host machine.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Flocker }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "downward_api",
-						Description:   "Optional: mode bits to use on created files by default",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.DownwardAPI }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "fc",
-						Description:   "Fibre Channel resource that is attached to a kubelet's host machine.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.FC }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "azure_file",
-						Description:   "Azure File Service mount on the host and bind mount to the pod.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.AzureFile }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:        "config_map",
-						Description: "configMap that should populate this volume",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.ConfigMap }),
-					},
-					{
-						Name:          "vsphere_volume",
-						Description:   "vSphere volume attached and mounted on kubelets host machine.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.VsphereVolume }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "quobyte",
-						Description:   "Quobyte mount on the host that shares a pod's lifetime.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Quobyte }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "azure_disk",
-						Description:   "The Name of the data disk in the blob storage",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.AzureDisk }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "photon_persistent_disk",
-						Description:   "PhotonController persistent disk attached and mounted on kubelets host machine.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.PhotonPersistentDisk }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:        "projected",
-						Description: "Items for all in one resources secrets, configmaps, and downward API.",
-						Type:        schema.TypeJSON,
-						Resolver:    resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Projected }),
-					},
-					{
-						Name:          "portworx_volume",
-						Description:   "Portworx volume attached and mounted on kubelets host machine.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.PortworxVolume }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "scale_io",
-						Description:   "ScaleIO persistent volume attached and mounted on Kubernetes nodes.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.ScaleIO }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "storage_os",
-						Description:   "StorageOS represents a StorageOS volume attached and mounted on Kubernetes nodes.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.StorageOS }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "csi",
-						Description:   "CSI (Container Storage Interface) represents ephemeral storage that is handled by certain external CSI drivers (Beta feature).",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.CSI }),
-						IgnoreInTests: true,
-					},
-					{
-						Name:          "ephemeral",
-						Description:   "Ephemeral represents a volume that is handled by a cluster storage driver.",
-						Type:          schema.TypeJSON,
-						Resolver:      resolveVolumeJSONField(func(v corev1.Volume) interface{} { return v.Ephemeral }),
-						IgnoreInTests: true,
-					},
-				},
-			},
+			corePodSpec.volumesTable(),
 			{
 				IgnoreInTests: true,
 				Name:          "k8s_core_pod_init_container_statuses",
@@ -1785,6 +1172,36 @@ func resolveCorePodSecurityContext(ctx context.Context, meta schema.ClientMeta,
 	return diag.WrapError(resource.Set(c.Name, b))
 }
 
+func resolveCorePodSecurityContextField(field func(s client.SecurityContext) interface{}) schema.ColumnResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		pod := resource.Item.(corev1.Pod)
+		return diag.WrapError(resource.Set(c.Name, nilIfEmpty(field(client.PodSecurityContext(pod)))))
+	}
+}
+
+func resolveCorePodSELinuxOptions(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	pod := resource.Item.(corev1.Pod)
+	opts := client.PodSecurityContext(pod).SELinuxOptions
+	if opts == nil {
+		return nil
+	}
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+
+func resolveCorePodPSSMaxLevel(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	pod := resource.Item.(corev1.Pod)
+	version, err := meta.(*client.Client).PodSecurityVersion(ctx, pod.Namespace)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	violations := client.EvaluatePodSecurity(version, pod.ObjectMeta, pod.Spec)
+	return diag.WrapError(resource.Set(c.Name, string(client.PodSecurityMaxLevel(violations))))
+}
+
 func resolveCorePodImagePullSecrets(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	pod := resource.Item.(corev1.Pod)
 	b, err := json.Marshal(pod.Spec.ImagePullSecrets)
@@ -1857,53 +1274,6 @@ func resolveCorePodConditions(ctx context.Context, meta schema.ClientMeta, resou
 	return diag.WrapError(resource.Set(c.Name, b))
 }
 
-func fetchCorePodInitContainers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
-	pod := parent.Item.(corev1.Pod)
-	res <- pod.Spec.InitContainers
-	return nil
-}
-
-func resolveContainerJSONField(fieldResolver func(c corev1.Container) interface{}) func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
-	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
-		cont := resource.Item.(corev1.Container)
-		b, err := json.Marshal(fieldResolver(cont))
-		if err != nil {
-			return diag.WrapError(err)
-		}
-		return diag.WrapError(resource.Set(c.Name, b))
-	}
-}
-
-func fetchCorePodContainerPorts(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
-	cont := parent.Item.(corev1.Container)
-	res <- cont.Ports
-	return nil
-}
-
-func fetchCorePodContainerEnvs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
-	cont := parent.Item.(corev1.Container)
-	res <- cont.Env
-	return nil
-}
-
-func fetchCorePodContainerVolumeMounts(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
-	cont := parent.Item.(corev1.Container)
-	res <- cont.VolumeMounts
-	return nil
-}
-
-func fetchCorePodContainerVolumeDevices(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
-	cont := parent.Item.(corev1.Container)
-	res <- cont.VolumeDevices
-	return nil
-}
-
-func fetchCorePodContainers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
-	pod := parent.Item.(corev1.Pod)
-	res <- pod.Spec.Containers
-	return nil
-}
-
 func fetchCorePodEphemeralContainers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
 	pod := parent.Item.(corev1.Pod)
 	res <- pod.Spec.EphemeralContainers
@@ -1945,23 +1315,6 @@ func fetchCorePodEphemeralContainerVolumeDevices(ctx context.Context, meta schem
 	return nil
 }
 
-func fetchCorePodVolumes(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
-	pod := parent.Item.(corev1.Pod)
-	res <- pod.Spec.Volumes
-	return nil
-}
-
-func resolveVolumeJSONField(fieldResolver func(v corev1.Volume) interface{}) func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
-	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
-		vol := resource.Item.(corev1.Volume)
-		b, err := json.Marshal(fieldResolver(vol))
-		if err != nil {
-			return diag.WrapError(err)
-		}
-		return diag.WrapError(resource.Set(c.Name, b))
-	}
-}
-
 func fetchCorePodInitContainerStatuses(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
 	pod := parent.Item.(corev1.Pod)
 	res <- pod.Status.InitContainerStatuses
@@ -1990,3 +1343,43 @@ func fetchCorePodEphemeralContainerStatuses(ctx context.Context, meta schema.Cli
 	res <- pod.Status.EphemeralContainerStatuses
 	return nil
 }
+
+// resolvePodContainerImageID resolves the digest of the image a container runs from the matching container status
+// of its pod.
+func resolvePodContainerImageID(statuses func(s corev1.PodStatus) []corev1.ContainerStatus) schema.ColumnResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		pod := resource.Parent.Item.(corev1.Pod)
+		name, _ := funk.Get(resource.Item, "Name").(string)
+		for _, status := range statuses(pod.Status) {
+			if status.Name != name {
+				continue
+			}
+			if digest := client.ImageIDDigest(status.ImageID); digest != "" {
+				return diag.WrapError(resource.Set(c.Name, digest))
+			}
+			return nil
+		}
+		return nil
+	}
+}
+
+// nilIfEmpty stores empty strings and lists as null.
+func nilIfEmpty(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+	case []string:
+		if len(t) == 0 {
+			return nil
+		}
+	}
+	return v
+}
+
+// podTemplate returns the metadata and spec of a pod as a pod template.
+func podTemplate(item interface{}) corev1.PodTemplateSpec {
+	pod := item.(corev1.Pod)
+	return corev1.PodTemplateSpec{ObjectMeta: pod.ObjectMeta, Spec: pod.Spec}
+}
@@ -0,0 +1,272 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ReplicationControllers() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_core_replication_controllers",
+		Description:  "ReplicationController represents the configuration of a replication controller.",
+		Resolver:     fetchCoreReplicationControllers,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within a namespace",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveCoreReplicationControllersOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveCoreReplicationControllersManagedFields,
+			},
+			{
+				Name:        "replicas",
+				Description: "Replicas is the number of desired replicas.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Spec.Replicas"),
+			},
+			{
+				Name:        "min_ready_seconds",
+				Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Spec.MinReadySeconds"),
+			},
+			{
+				Name:        "selector",
+				Description: "Selector is a label query over pods that should match the Replicas count.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("Spec.Selector"),
+			},
+			{
+				Name:        "template",
+				Description: "Template is the object that describes the pod that will be created if insufficient replicas are detected.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveCoreReplicationControllersTemplate,
+			},
+			{
+				Name:        "status_replicas",
+				Description: "Replicas is the most recently observed number of replicas.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.Replicas"),
+			},
+			{
+				Name:        "status_fully_labeled_replicas",
+				Description: "The number of pods that have labels matching the labels of the pod template of the replication controller.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.FullyLabeledReplicas"),
+			},
+			{
+				Name:        "status_ready_replicas",
+				Description: "The number of ready replicas for this replication controller.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.ReadyReplicas"),
+			},
+			{
+				Name:        "status_available_replicas",
+				Description: "The number of available replicas (ready for at least minReadySeconds) for this replication controller.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.AvailableReplicas"),
+			},
+			{
+				Name:        "status_observed_generation",
+				Description: "ObservedGeneration reflects the generation of the most recently observed replication controller.",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("Status.ObservedGeneration"),
+			},
+		},
+		Relations: append([]*schema.Table{
+			{
+				Name:          "k8s_core_replication_controller_status_conditions",
+				Description:   "ReplicationControllerCondition describes the state of a replication controller at a certain point.",
+				Resolver:      fetchCoreReplicationControllerStatusConditions,
+				IgnoreInTests: true,
+				Columns: []schema.Column{
+					{
+						Name:        "replication_controller_cq_id",
+						Description: "Unique CloudQuery ID of k8s_core_replication_controllers table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "type",
+						Description: "Type of replication controller condition.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "status",
+						Description: "Status of the condition, one of True, False, Unknown.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "reason",
+						Description: "The reason for the condition's last transition.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "message",
+						Description: "A human readable message indicating details about the transition.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+		}, PodTemplateTables("k8s_core_replication_controller", replicationControllerPodTemplate)...),
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchCoreReplicationControllers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client).Services().ReplicationControllers
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveCoreReplicationControllersOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(corev1.ReplicationController)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCoreReplicationControllersManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(corev1.ReplicationController)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveCoreReplicationControllersTemplate(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(corev1.ReplicationController)
+	b, err := json.Marshal(p.Spec.Template)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func fetchCoreReplicationControllerStatusConditions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(corev1.ReplicationController)
+	res <- p.Status.Conditions
+	return nil
+}
+
+func replicationControllerPodTemplate(item interface{}) corev1.PodTemplateSpec {
+	if t := item.(corev1.ReplicationController).Spec.Template; t != nil {
+		return *t
+	}
+	return corev1.PodTemplateSpec{}
+}
@@ -0,0 +1,45 @@
+//go:build mock
+// +build mock
+
+package core
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createReplicationControllers(t *testing.T, ctrl *gomock.Controller) client.Services {
+	controllers := mocks.NewMockReplicationControllersClient(ctrl)
+	controllers.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.ReplicationControllerList{Items: []corev1.ReplicationController{fakeReplicationController(t)}}, nil,
+	)
+	return client.Services{
+		ReplicationControllers: controllers,
+	}
+}
+
+func fakeReplicationController(t *testing.T) corev1.ReplicationController {
+	var rc corev1.ReplicationController
+	k8sTesting.FakeThroughPointers(t,
+		&rc.TypeMeta,
+		&rc.ObjectMeta,
+		&rc.Spec.Replicas,
+		&rc.Spec.MinReadySeconds,
+		&rc.Spec.Selector,
+		&rc.Status,
+	)
+	rc.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	template := k8sTesting.FakePodTemplateSpec(t)
+	rc.Spec.Template = &template
+	return rc
+}
+
+func TestReplicationControllers(t *testing.T) {
+	client.K8sMockTestHelper(t, ReplicationControllers(), createReplicationControllers, client.TestOptions{})
+}
@@ -148,6 +148,104 @@ func ResourceQuotas() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    schema.PathResolver("Status.Used"),
 			},
+			{
+				Name:          "cpu_request_hard_millicores",
+				Description:   "Hard limit enforced by the quota on the CPU requests of the namespace, in millicores.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListMilliValueResolver("Status.Hard", corev1.ResourceRequestsCPU, corev1.ResourceCPU),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "cpu_request_used_millicores",
+				Description:   "CPU requests currently used in the namespace, in millicores.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListMilliValueResolver("Status.Used", corev1.ResourceRequestsCPU, corev1.ResourceCPU),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "cpu_limit_hard_millicores",
+				Description:   "Hard limit enforced by the quota on the CPU limits of the namespace, in millicores.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListMilliValueResolver("Status.Hard", corev1.ResourceLimitsCPU),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "cpu_limit_used_millicores",
+				Description:   "CPU limits currently used in the namespace, in millicores.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListMilliValueResolver("Status.Used", corev1.ResourceLimitsCPU),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "memory_request_hard_bytes",
+				Description:   "Hard limit enforced by the quota on the memory requests of the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Hard", corev1.ResourceRequestsMemory, corev1.ResourceMemory),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "memory_request_used_bytes",
+				Description:   "Memory requests currently used in the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Used", corev1.ResourceRequestsMemory, corev1.ResourceMemory),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "memory_limit_hard_bytes",
+				Description:   "Hard limit enforced by the quota on the memory limits of the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Hard", corev1.ResourceLimitsMemory),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "memory_limit_used_bytes",
+				Description:   "Memory limits currently used in the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Used", corev1.ResourceLimitsMemory),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "ephemeral_storage_request_hard_bytes",
+				Description:   "Hard limit enforced by the quota on the ephemeral storage requests of the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Hard", corev1.ResourceRequestsEphemeralStorage, corev1.ResourceEphemeralStorage),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "ephemeral_storage_request_used_bytes",
+				Description:   "Ephemeral storage requests currently used in the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Used", corev1.ResourceRequestsEphemeralStorage, corev1.ResourceEphemeralStorage),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "ephemeral_storage_limit_hard_bytes",
+				Description:   "Hard limit enforced by the quota on the ephemeral storage limits of the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Hard", corev1.ResourceLimitsEphemeralStorage),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "ephemeral_storage_limit_used_bytes",
+				Description:   "Ephemeral storage limits currently used in the namespace, in bytes.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListValueResolver("Status.Used", corev1.ResourceLimitsEphemeralStorage),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "gpu_request_hard",
+				Description:   "Hard limit enforced by the quota on the GPU requests of the namespace, summed over the GPU extended resources of every vendor.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListGPUResolver("Status.Hard", corev1.DefaultResourceRequestsPrefix),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "gpu_request_used",
+				Description:   "GPU requests currently used in the namespace, summed over the GPU extended resources of every vendor.",
+				Type:          schema.TypeBigInt,
+				Resolver:      client.ResourceListGPUResolver("Status.Used", corev1.DefaultResourceRequestsPrefix),
+				IgnoreInTests: true,
+			},
 		},
 		Relations: []*schema.Table{
 			{
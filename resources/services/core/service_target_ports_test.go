@@ -0,0 +1,74 @@
+package core
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestResolveServiceTargets(t *testing.T) {
+	service := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "svc"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "web"},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromString("http")},
+				{Name: "metrics", Port: 9090},
+				{Name: "admin", Port: 81, TargetPort: intstr.FromInt(8081)},
+				{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP, TargetPort: intstr.FromString("dns")},
+			},
+		},
+	}
+	ready := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", UID: "pod-1", Labels: map[string]string{"app": "web", "tier": "frontend"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Ports: []corev1.ContainerPort{
+			{Name: "http", ContainerPort: 8080},
+			{Name: "dns", ContainerPort: 5353, Protocol: corev1.ProtocolTCP},
+		}}}},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+	}
+	other := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-1", UID: "pod-2", Labels: map[string]string{"app": "db"}}}
+	completed := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-migrate", UID: "pod-3", Labels: map[string]string{"app": "web"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+	}
+	failed := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", UID: "pod-4", Labels: map[string]string{"app": "web"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodFailed},
+	}
+	deleted := metav1.Now()
+	terminating := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-2", UID: "pod-5", Labels: map[string]string{"app": "web"}, DeletionTimestamp: &deleted}}
+
+	targets := resolveServiceTargets(service, []corev1.Pod{ready, other, completed, failed, terminating})
+	if len(targets) != 4 {
+		t.Fatalf("expected 4 targets, got %d: %+v", len(targets), targets)
+	}
+	want := map[string]int32{"http": 8080, "metrics": 9090, "admin": 8081}
+	for _, target := range targets {
+		if target.PodUID != "pod-1" || !target.PodReady {
+			t.Errorf("unexpected target pod %+v", target)
+		}
+		expected, ok := want[target.PortName]
+		switch {
+		case !ok && target.TargetPort != nil:
+			// the dns container port is TCP, so the UDP service port doesn't resolve
+			t.Errorf("%s: expected unresolved target port, got %d", target.PortName, *target.TargetPort)
+		case ok && (target.TargetPort == nil || *target.TargetPort != expected):
+			t.Errorf("%s: expected target port %d, got %v", target.PortName, expected, target.TargetPort)
+		}
+	}
+
+	external := service
+	external.Spec.Type = corev1.ServiceTypeExternalName
+	external.Spec.ExternalName = "web.example.com"
+	if targets := resolveServiceTargets(external, []corev1.Pod{ready}); len(targets) != 0 {
+		t.Errorf("expected ExternalName services to have no targets, got %+v", targets)
+	}
+
+	service.Spec.Selector = nil
+	if targets := resolveServiceTargets(service, []corev1.Pod{ready}); len(targets) != 0 {
+		t.Errorf("expected services without selector to have no targets, got %+v", targets)
+	}
+}
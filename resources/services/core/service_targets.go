@@ -0,0 +1,200 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// serviceTarget is a port of a service resolved against a pod matched by the service selector.
+type serviceTarget struct {
+	ServiceUID     string
+	ServiceName    string
+	Namespace      string
+	PodUID         string
+	PodName        string
+	PodReady       bool
+	PortName       string
+	Port           int32
+	Protocol       string
+	TargetPort     *int32
+	TargetPortName string
+}
+
+func ServiceTargets() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_core_service_targets",
+		Description:  "Pods selected by the selector of a service in its namespace, with each service port resolved to the port of the pod it targets. Pods that have succeeded, failed or are being deleted are skipped, as are ExternalName services.",
+		Resolver:     fetchCoreServiceTargets,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "service_uid",
+				Description: "UID of the service.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ServiceUID"),
+			},
+			{
+				Name:        "service_name",
+				Description: "Name of the service.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace of the service and the pod.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "pod_uid",
+				Description: "UID of the pod matched by the service selector.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("PodUID"),
+			},
+			{
+				Name:        "pod_name",
+				Description: "Name of the pod matched by the service selector.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "pod_ready",
+				Description: "Whether the pod is ready, i.e. receives traffic from the service.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:          "port_name",
+				Description:   "Name of the service port, empty if the service has a single unnamed port.",
+				Type:          schema.TypeString,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "port",
+				Description: "Port exposed by the service.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:        "protocol",
+				Description: "IP protocol of the port: TCP, UDP or SCTP.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "target_port",
+				Description: "Number of the pod port the service port targets. Null if the target port is named and none of the pod containers exposes a port with that name and protocol.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:          "target_port_name",
+				Description:   "Name of the container port the service port targets, empty if the target port is given by number.",
+				Type:          schema.TypeString,
+				IgnoreInTests: true,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchCoreServiceTargets(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services()
+	services, err := client.ListAll(ctx, svc.Services.List, func(l *corev1.ServiceList) []corev1.Service { return l.Items })
+	if err != nil {
+		return err
+	}
+	pods, err := client.ListAll(ctx, svc.Pods.List, func(l *corev1.PodList) []corev1.Pod { return l.Items })
+	if err != nil {
+		return err
+	}
+	podsByNamespace := make(map[string][]corev1.Pod)
+	for _, p := range pods {
+		podsByNamespace[p.Namespace] = append(podsByNamespace[p.Namespace], p)
+	}
+	for _, s := range services {
+		res <- resolveServiceTargets(s, podsByNamespace[s.Namespace])
+	}
+	return nil
+}
+
+// resolveServiceTargets returns the ports of a service resolved against each of the given pods its selector
+// matches. Services without a selector don't select pods, their endpoints are managed by hand, and ExternalName
+// services are only a DNS alias.
+func resolveServiceTargets(s corev1.Service, pods []corev1.Pod) []serviceTarget {
+	if len(s.Spec.Selector) == 0 || s.Spec.Type == corev1.ServiceTypeExternalName {
+		return nil
+	}
+	selector := labels.SelectorFromSet(s.Spec.Selector)
+	var targets []serviceTarget
+	for _, p := range pods {
+		// the endpoints controller doesn't route to pods that have finished or are being deleted
+		if p.DeletionTimestamp != nil || p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if !selector.Matches(labels.Set(p.Labels)) {
+			continue
+		}
+		for _, port := range s.Spec.Ports {
+			t := serviceTarget{
+				ServiceUID:  string(s.UID),
+				ServiceName: s.Name,
+				Namespace:   s.Namespace,
+				PodUID:      string(p.UID),
+				PodName:     p.Name,
+				PodReady:    podReady(p),
+				PortName:    port.Name,
+				Port:        port.Port,
+				Protocol:    string(portProtocol(port.Protocol)),
+			}
+			if port.TargetPort.Type == intstr.String {
+				t.TargetPortName = port.TargetPort.StrVal
+			}
+			if target, ok := resolveTargetPort(port, p); ok {
+				t.TargetPort = &target
+			}
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// resolveTargetPort resolves the target port of a service port against the container ports of a pod, the same way
+// the endpoints controller does. Target ports default to the service port.
+func resolveTargetPort(port corev1.ServicePort, p corev1.Pod) (int32, bool) {
+	switch {
+	case port.TargetPort.Type == intstr.String:
+		for _, c := range p.Spec.Containers {
+			for _, cp := range c.Ports {
+				if cp.Name == port.TargetPort.StrVal && portProtocol(cp.Protocol) == portProtocol(port.Protocol) {
+					return cp.ContainerPort, true
+				}
+			}
+		}
+		return 0, false
+	case port.TargetPort.IntVal == 0:
+		return port.Port, true
+	default:
+		return port.TargetPort.IntVal, true
+	}
+}
+
+func portProtocol(p corev1.Protocol) corev1.Protocol {
+	if p == "" {
+		return corev1.ProtocolTCP
+	}
+	return p
+}
+
+func podReady(p corev1.Pod) bool {
+	for _, c := range p.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+//go:build mock
+// +build mock
+
+package core
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func createCoreServiceTargets(t *testing.T, ctrl *gomock.Controller) client.Services {
+	pod := k8sTesting.FakePod(t)
+	pod.Namespace = "default"
+	pod.Labels = map[string]string{"app": "web"}
+	pod.DeletionTimestamp = nil
+	pod.Status.Phase = corev1.PodRunning
+	pod.Spec.Containers[0].Ports = []corev1.ContainerPort{{Name: "http", ContainerPort: 8080, Protocol: corev1.ProtocolTCP}}
+	service := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "service-uid"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "web"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromString("http")}},
+		},
+	}
+
+	services := mocks.NewMockServicesClient(ctrl)
+	services.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.ServiceList{Items: []corev1.Service{service}}, nil,
+	)
+	pods := mocks.NewMockPodsClient(ctrl)
+	pods.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.PodList{Items: []corev1.Pod{pod}}, nil,
+	)
+	return client.Services{
+		Pods:     pods,
+		Services: services,
+	}
+}
+
+func TestCoreServiceTargets(t *testing.T) {
+	client.K8sMockTestHelper(t, ServiceTargets(), createCoreServiceTargets, client.TestOptions{})
+}
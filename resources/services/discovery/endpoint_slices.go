@@ -0,0 +1,351 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func EndpointSlices() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_discovery_endpoint_slices",
+		Description:  "EndpointSlice represents a subset of the endpoints that implement a service.",
+		Resolver:     fetchDiscoveryEndpointSlices,
+		Multiplex:    client.APIFilterContextMultiplex("/apis/discovery.k8s.io/v1/endpointslices"),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within a namespace",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveDiscoveryEndpointSlicesOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveDiscoveryEndpointSlicesManagedFields,
+			},
+			{
+				Name:        "address_type",
+				Description: "Specifies the type of address carried by this EndpointSlice. One of IPv4, IPv6, FQDN.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "service_name",
+				Description: "Name of the service this EndpointSlice belongs to, taken from the kubernetes.io/service-name label.",
+				Type:        schema.TypeString,
+				Resolver:    resolveDiscoveryEndpointSlicesServiceName,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "k8s_discovery_endpoint_slice_endpoints",
+				Description: "Endpoint represents a single logical backend implementing a service.",
+				Resolver:    fetchDiscoveryEndpointSliceEndpoints,
+				Columns: []schema.Column{
+					{
+						Name:        "endpoint_slice_cq_id",
+						Description: "Unique CloudQuery ID of k8s_discovery_endpoint_slices table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "addresses",
+						Description: "Addresses of this endpoint. The contents of this field are interpreted according to the corresponding EndpointSlice addressType field.",
+						Type:        schema.TypeInetArray,
+						Resolver:    resolveDiscoveryEndpointSliceEndpointsAddresses,
+					},
+					{
+						Name:        "fqdn_addresses",
+						Description: "Addresses of this endpoint that are not IPs, set instead of addresses for slices of the FQDN address type.",
+						Type:        schema.TypeStringArray,
+						Resolver:    resolveDiscoveryEndpointSliceEndpointsFqdnAddresses,
+					},
+					{
+						Name:        "ready",
+						Description: "Ready indicates that this endpoint is prepared to receive traffic, according to whatever system is managing the endpoint.",
+						Type:        schema.TypeBool,
+						Resolver:    schema.PathResolver("Conditions.Ready"),
+					},
+					{
+						Name:        "serving",
+						Description: "Serving is identical to ready except that it is set regardless of the terminating state of endpoints.",
+						Type:        schema.TypeBool,
+						Resolver:    schema.PathResolver("Conditions.Serving"),
+					},
+					{
+						Name:        "terminating",
+						Description: "Terminating indicates that this endpoint is terminating.",
+						Type:        schema.TypeBool,
+						Resolver:    schema.PathResolver("Conditions.Terminating"),
+					},
+					{
+						Name:        "hostname",
+						Description: "Hostname of this endpoint. This field may be used by consumers of endpoints to distinguish endpoints from each other (e.g. in DNS names).",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "node_name",
+						Description: "Represents the name of the Node hosting this endpoint.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "zone",
+						Description: "The name of the Zone this endpoint exists in.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "target_ref_kind",
+						Description: "Kind of the referent.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("TargetRef.Kind"),
+					},
+					{
+						Name:        "target_ref_namespace",
+						Description: "Namespace of the referent.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("TargetRef.Namespace"),
+					},
+					{
+						Name:        "target_ref_name",
+						Description: "Name of the referent.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("TargetRef.Name"),
+					},
+					{
+						Name:        "target_ref_uid",
+						Description: "UID of the referent.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("TargetRef.UID"),
+					},
+					{
+						Name:          "hints_for_zones",
+						Description:   "Zones this endpoint should be consumed by to enable topology aware routing.",
+						Type:          schema.TypeStringArray,
+						Resolver:      resolveDiscoveryEndpointSliceEndpointsHintsForZones,
+						IgnoreInTests: true,
+					},
+				},
+			},
+			{
+				Name:        "k8s_discovery_endpoint_slice_ports",
+				Description: "EndpointPort represents a Port used by an EndpointSlice.",
+				Resolver:    fetchDiscoveryEndpointSlicePorts,
+				Columns: []schema.Column{
+					{
+						Name:        "endpoint_slice_cq_id",
+						Description: "Unique CloudQuery ID of k8s_discovery_endpoint_slices table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "name",
+						Description: "The name of this port.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "port",
+						Description: "The port number of the endpoint.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "protocol",
+						Description: "The IP protocol for this port. Must be UDP, TCP, or SCTP.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:          "app_protocol",
+						Description:   "The application protocol for this port.",
+						Type:          schema.TypeString,
+						IgnoreInTests: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchDiscoveryEndpointSlices(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client).Services().EndpointSlices
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveDiscoveryEndpointSlicesOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(discoveryv1.EndpointSlice)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveDiscoveryEndpointSlicesManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(discoveryv1.EndpointSlice)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveDiscoveryEndpointSlicesServiceName(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(discoveryv1.EndpointSlice)
+	return diag.WrapError(resource.Set(c.Name, p.Labels[discoveryv1.LabelServiceName]))
+}
+
+func fetchDiscoveryEndpointSliceEndpoints(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(discoveryv1.EndpointSlice)
+	res <- p.Endpoints
+	return nil
+}
+
+// resolveDiscoveryEndpointSliceEndpointsAddresses skips addresses that are not IPs, e.g. the ones of FQDN slices,
+// they are stored in fqdn_addresses instead.
+func resolveDiscoveryEndpointSliceEndpointsAddresses(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(discoveryv1.Endpoint)
+	addresses := make([]net.IP, 0, len(p.Addresses))
+	for _, a := range p.Addresses {
+		if ip := net.ParseIP(a); ip != nil {
+			addresses = append(addresses, ip)
+		}
+	}
+	return diag.WrapError(resource.Set(c.Name, addresses))
+}
+
+func resolveDiscoveryEndpointSliceEndpointsFqdnAddresses(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(discoveryv1.Endpoint)
+	var addresses []string
+	for _, a := range p.Addresses {
+		if net.ParseIP(a) == nil {
+			addresses = append(addresses, a)
+		}
+	}
+	return diag.WrapError(resource.Set(c.Name, addresses))
+}
+
+func resolveDiscoveryEndpointSliceEndpointsHintsForZones(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(discoveryv1.Endpoint)
+	if p.Hints == nil {
+		return nil
+	}
+	zones := make([]string, 0, len(p.Hints.ForZones))
+	for _, z := range p.Hints.ForZones {
+		zones = append(zones, z.Name)
+	}
+	return diag.WrapError(resource.Set(c.Name, zones))
+}
+
+func fetchDiscoveryEndpointSlicePorts(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(discoveryv1.EndpointSlice)
+	res <- p.Ports
+	return nil
+}
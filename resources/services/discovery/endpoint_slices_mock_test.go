@@ -0,0 +1,55 @@
+//go:build mock
+// +build mock
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createEndpointSlices(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEndpointSlicesClient(ctrl)
+	m.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&discoveryv1.EndpointSliceList{Items: []discoveryv1.EndpointSlice{fakeEndpointSlice(t), fakeFQDNEndpointSlice(t)}}, nil,
+	)
+	return client.Services{
+		EndpointSlices: m,
+	}
+}
+
+func fakeEndpointSlice(t *testing.T) discoveryv1.EndpointSlice {
+	var es discoveryv1.EndpointSlice
+	k8sTesting.FakeThroughPointers(t, &es.TypeMeta, &es.ObjectMeta, &es.Ports)
+	es.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	es.Labels[discoveryv1.LabelServiceName] = "web"
+	es.AddressType = discoveryv1.AddressTypeIPv4
+
+	var endpoint discoveryv1.Endpoint
+	var targetRef corev1.ObjectReference
+	k8sTesting.FakeThroughPointers(t, &endpoint.Conditions, &endpoint.Hostname, &endpoint.NodeName, &endpoint.Zone, &targetRef)
+	endpoint.Addresses = []string{"10.0.0.1", "10.0.0.2"}
+	endpoint.TargetRef = &targetRef
+	endpoint.Hints = &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: "us-east-1a"}}}
+	es.Endpoints = []discoveryv1.Endpoint{endpoint}
+	return es
+}
+
+func fakeFQDNEndpointSlice(t *testing.T) discoveryv1.EndpointSlice {
+	es := fakeEndpointSlice(t)
+	es.UID = "fqdn"
+	es.AddressType = discoveryv1.AddressTypeFQDN
+	es.Endpoints[0].Addresses = []string{"db.example.com"}
+	return es
+}
+
+func TestEndpointSlices(t *testing.T) {
+	client.K8sMockTestHelper(t, EndpointSlices(), createEndpointSlices, client.TestOptions{})
+}
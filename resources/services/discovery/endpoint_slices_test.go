@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationEndpointSlices(t *testing.T) {
+	client.K8sTestHelper(t, EndpointSlices(), "./snapshots")
+}
@@ -0,0 +1,326 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Events() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_events_events",
+		Description:  "Event is a report of an event somewhere in the cluster, as served by the events.k8s.io/v1 API.",
+		Resolver:     fetchEventsEvents,
+		Multiplex:    client.APIFilterContextMultiplex("/apis/events.k8s.io/v1/events"),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within a namespace",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveEventsEventsOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveEventsEventsManagedFields,
+			},
+			{
+				Name:          "event_time",
+				Description:   "Time when this Event was first observed.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("EventTime"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "series_count",
+				Description:   "Number of occurrences in this series up to the last heartbeat time.",
+				Type:          schema.TypeInt,
+				Resolver:      schema.PathResolver("Series.Count"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "series_last_observed_time",
+				Description:   "Time when last Event from the series was seen before last heartbeat.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("Series.LastObservedTime"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "reporting_controller",
+				Description: "Name of the controller that emitted this Event, e.g. `kubernetes.io/kubelet`.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "reporting_instance",
+				Description: "ID of the controller instance, e.g. `kubelet-xyzf`.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "action",
+				Description: "What action was taken/failed regarding to the regarding object.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "reason",
+				Description: "Why the action was taken.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "regarding_kind",
+				Description: "Kind of the regarding object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Regarding.Kind"),
+			},
+			{
+				Name:        "regarding_namespace",
+				Description: "Namespace of the regarding object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Regarding.Namespace"),
+			},
+			{
+				Name:        "regarding_name",
+				Description: "Name of the regarding object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Regarding.Name"),
+			},
+			{
+				Name:        "regarding_uid",
+				Description: "UID of the regarding object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Regarding.UID"),
+			},
+			{
+				Name:        "regarding_api_version",
+				Description: "API version of the regarding object.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Regarding.APIVersion"),
+			},
+			{
+				Name:        "regarding_resource_version",
+				Description: "Specific resourceVersion to which this reference is made, if any.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Regarding.ResourceVersion"),
+			},
+			{
+				Name:        "regarding_field_path",
+				Description: "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2].",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Regarding.FieldPath"),
+			},
+			{
+				Name:          "related",
+				Description:   "Optional secondary object for more complex actions.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolveEventsEventsRelated,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "note",
+				Description: "A human-readable description of the status of this operation.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "type",
+				Description: "Type of this event (Normal, Warning), new types could be added in the future.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "deprecated_source_component",
+				Description: "Component from which the event is generated.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("DeprecatedSource.Component"),
+			},
+			{
+				Name:        "deprecated_source_host",
+				Description: "Node name on which the event is generated.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("DeprecatedSource.Host"),
+			},
+			{
+				Name:          "deprecated_first_timestamp",
+				Description:   "The deprecated field assuring backward compatibility with core.v1 Event type.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("DeprecatedFirstTimestamp"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "deprecated_last_timestamp",
+				Description:   "The deprecated field assuring backward compatibility with core.v1 Event type.",
+				Type:          schema.TypeTimestamp,
+				Resolver:      client.TimeResolver("DeprecatedLastTimestamp"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "deprecated_count",
+				Description: "The deprecated field assuring backward compatibility with core.v1 Event type.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:        "last_seen",
+				Description: "The most recent time the event was observed, taken from the series, event time, deprecated timestamps or creation timestamp, whichever is the latest.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    resolveEventsEventsLastSeen,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchEventsEvents(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	cl := c.Services().EventsV1
+	cutoff := c.EventsCutoff()
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- client.EventsSeenSince(result.Items, cutoff, eventLastSeen)
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveEventsEventsOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(eventsv1.Event)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveEventsEventsManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(eventsv1.Event)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveEventsEventsRelated(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(eventsv1.Event)
+	if p.Related == nil {
+		return nil
+	}
+	b, err := json.Marshal(p.Related)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolveEventsEventsLastSeen(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(eventsv1.Event)
+	return diag.WrapError(resource.Set(c.Name, eventLastSeen(p)))
+}
+
+// eventLastSeen returns the latest of the times an event records. Events converted from core/v1 may only have the deprecated timestamps set.
+func eventLastSeen(e eventsv1.Event) time.Time {
+	times := []time.Time{e.CreationTimestamp.Time, e.EventTime.Time, e.DeprecatedFirstTimestamp.Time, e.DeprecatedLastTimestamp.Time}
+	if e.Series != nil {
+		times = append(times, e.Series.LastObservedTime.Time)
+	}
+	return client.EventLastSeen(times...)
+}
@@ -0,0 +1,45 @@
+//go:build mock
+// +build mock
+
+package events
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createEventsEvents(t *testing.T, ctrl *gomock.Controller) client.Services {
+	events := mocks.NewMockEventsV1Client(ctrl)
+	var e eventsv1.Event
+	k8sTesting.FakeThroughPointers(t,
+		&e.TypeMeta,
+		&e.ObjectMeta,
+		&e.ReportingController,
+		&e.ReportingInstance,
+		&e.Action,
+		&e.Reason,
+		&e.Regarding,
+		&e.Note,
+		&e.Type,
+		&e.DeprecatedSource,
+		&e.DeprecatedCount,
+	)
+	e.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	e.EventTime = metav1.NowMicro()
+	events.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&eventsv1.EventList{Items: []eventsv1.Event{e}}, nil,
+	)
+	return client.Services{
+		EventsV1: events,
+	}
+}
+
+func TestEventsEvents(t *testing.T) {
+	client.K8sMockTestHelper(t, Events(), createEventsEvents, client.TestOptions{})
+}
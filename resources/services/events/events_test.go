@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package events
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationEventsEvents(t *testing.T) {
+	client.K8sTestHelper(t, Events(), "./snapshots")
+}
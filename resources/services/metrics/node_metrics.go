@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NodeMetrics() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_metrics_node_metrics",
+		Description:  "NodeMetrics sets resource usage metrics of a node, as reported by the metrics API.",
+		Resolver:     fetchMetricsNodeMetrics,
+		Multiplex:    client.APIFilterContextMultiplex(client.NodeMetricsPath),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"context", "name"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "name",
+				Description: "Name of the node.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "labels",
+				Description: "Labels of the node.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The end of the window the usage was collected in.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    client.TimeResolver("Timestamp"),
+			},
+			{
+				Name:        "window_seconds",
+				Description: "Length of the window the usage was collected in, in seconds.",
+				Type:        schema.TypeFloat,
+				Resolver:    resolveMetricsNodeMetricsWindowSeconds,
+			},
+			{
+				Name:        "usage",
+				Description: "The memory and CPU usage of the node.",
+				Type:        schema.TypeJSON,
+			},
+			{
+				Name:        "cpu_usage_millicores",
+				Description: "CPU usage of the node, in millicores.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListMilliValueResolver("Usage", corev1.ResourceCPU),
+			},
+			{
+				Name:        "memory_usage_bytes",
+				Description: "Memory usage of the node, in bytes.",
+				Type:        schema.TypeBigInt,
+				Resolver:    client.ResourceListValueResolver("Usage", corev1.ResourceMemory),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchMetricsNodeMetrics(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	cl := c.Services().NodeMetrics
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if errors.IsServiceUnavailable(err) {
+			c.Logger().Warn("metrics API is not available", "err", err)
+			return nil
+		}
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveMetricsNodeMetricsWindowSeconds(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.NodeMetrics)
+	return diag.WrapError(resource.Set(c.Name, p.Window.Seconds()))
+}
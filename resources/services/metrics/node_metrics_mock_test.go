@@ -0,0 +1,40 @@
+//go:build mock
+// +build mock
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createNodeMetrics(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockNodeMetricsClient(ctrl)
+	m.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&client.NodeMetricsList{Items: []client.NodeMetrics{fakeNodeMetrics(t)}}, nil,
+	)
+	return client.Services{
+		NodeMetrics: m,
+	}
+}
+
+func fakeNodeMetrics(t *testing.T) client.NodeMetrics {
+	var nm client.NodeMetrics
+	k8sTesting.FakeThroughPointers(t, &nm.ObjectMeta)
+	nm.Timestamp = metav1.Now()
+	nm.Window = metav1.Duration{Duration: 20 * time.Second}
+	nm.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1200m"), corev1.ResourceMemory: resource.MustParse("3Gi")}
+	return nm
+}
+
+func TestNodeMetrics(t *testing.T) {
+	client.K8sMockTestHelper(t, NodeMetrics(), createNodeMetrics, client.TestOptions{})
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationNodeMetrics(t *testing.T) {
+	client.K8sTestHelper(t, NodeMetrics(), "./snapshots")
+}
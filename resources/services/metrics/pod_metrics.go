@@ -0,0 +1,162 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func PodMetrics() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_metrics_pod_metrics",
+		Description:  "PodMetrics sets resource usage metrics of a pod, as reported by the metrics API.",
+		Resolver:     fetchMetricsPodMetrics,
+		Multiplex:    client.APIFilterContextMultiplex(client.PodMetricsPath),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"context", "namespace", "name"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "name",
+				Description: "Name of the pod.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace of the pod.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "labels",
+				Description: "Labels of the pod.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The end of the window the usage was collected in.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    client.TimeResolver("Timestamp"),
+			},
+			{
+				Name:        "window_seconds",
+				Description: "Length of the window the usage was collected in, in seconds.",
+				Type:        schema.TypeFloat,
+				Resolver:    resolveMetricsPodMetricsWindowSeconds,
+			},
+			{
+				Name:        "cpu_usage_millicores",
+				Description: "CPU usage of all the containers of the pod, in millicores.",
+				Type:        schema.TypeBigInt,
+				Resolver:    resolveMetricsPodMetricsCPUUsageMillicores,
+			},
+			{
+				Name:        "memory_usage_bytes",
+				Description: "Memory usage of all the containers of the pod, in bytes.",
+				Type:        schema.TypeBigInt,
+				Resolver:    resolveMetricsPodMetricsMemoryUsageBytes,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "k8s_metrics_pod_metric_containers",
+				Description: "ContainerMetrics sets resource usage metrics of a container.",
+				Resolver:    fetchMetricsPodMetricContainers,
+				Columns: []schema.Column{
+					{
+						Name:        "pod_metric_cq_id",
+						Description: "Unique CloudQuery ID of k8s_metrics_pod_metrics table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "name",
+						Description: "Container name corresponding to the one from pod.spec.containers.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "usage",
+						Description: "The memory and CPU usage of the container.",
+						Type:        schema.TypeJSON,
+					},
+					{
+						Name:        "cpu_usage_millicores",
+						Description: "CPU usage of the container, in millicores.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListMilliValueResolver("Usage", corev1.ResourceCPU),
+					},
+					{
+						Name:        "memory_usage_bytes",
+						Description: "Memory usage of the container, in bytes.",
+						Type:        schema.TypeBigInt,
+						Resolver:    client.ResourceListValueResolver("Usage", corev1.ResourceMemory),
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchMetricsPodMetrics(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	cl := c.Services().PodMetrics
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if errors.IsServiceUnavailable(err) {
+			c.Logger().Warn("metrics API is not available", "err", err)
+			return nil
+		}
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolveMetricsPodMetricsWindowSeconds(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.PodMetrics)
+	return diag.WrapError(resource.Set(c.Name, p.Window.Seconds()))
+}
+func resolveMetricsPodMetricsCPUUsageMillicores(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.PodMetrics)
+	total := containersUsage(p.Containers, corev1.ResourceCPU)
+	return diag.WrapError(resource.Set(c.Name, total.MilliValue()))
+}
+func resolveMetricsPodMetricsMemoryUsageBytes(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(client.PodMetrics)
+	total := containersUsage(p.Containers, corev1.ResourceMemory)
+	return diag.WrapError(resource.Set(c.Name, total.Value()))
+}
+func fetchMetricsPodMetricContainers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(client.PodMetrics)
+	res <- p.Containers
+	return nil
+}
+
+// containersUsage sums the usage of the resource over the containers.
+func containersUsage(containers []client.ContainerMetrics, name corev1.ResourceName) resource.Quantity {
+	var total resource.Quantity
+	for _, c := range containers {
+		if q, ok := c.Usage[name]; ok {
+			total.Add(q)
+		}
+	}
+	return total
+}
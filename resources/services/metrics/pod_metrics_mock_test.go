@@ -0,0 +1,43 @@
+//go:build mock
+// +build mock
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createPodMetrics(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockPodMetricsClient(ctrl)
+	m.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&client.PodMetricsList{Items: []client.PodMetrics{fakePodMetrics(t)}}, nil,
+	)
+	return client.Services{
+		PodMetrics: m,
+	}
+}
+
+func fakePodMetrics(t *testing.T) client.PodMetrics {
+	var pm client.PodMetrics
+	k8sTesting.FakeThroughPointers(t, &pm.ObjectMeta)
+	pm.Timestamp = metav1.Now()
+	pm.Window = metav1.Duration{Duration: 30 * time.Second}
+	pm.Containers = []client.ContainerMetrics{
+		{Name: "app", Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("64Mi")}},
+		{Name: "sidecar", Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500u"), corev1.ResourceMemory: resource.MustParse("16Mi")}},
+	}
+	return pm
+}
+
+func TestPodMetrics(t *testing.T) {
+	client.K8sMockTestHelper(t, PodMetrics(), createPodMetrics, client.TestOptions{})
+}
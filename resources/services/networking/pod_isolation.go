@@ -0,0 +1,179 @@
+package networking
+
+import (
+	"context"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// podIsolation is the isolation of a pod by the network policies selecting it.
+type podIsolation struct {
+	PodUID             string
+	PodName            string
+	Namespace          string
+	IngressIsolated    bool
+	EgressIsolated     bool
+	IngressPolicies    []string
+	EgressPolicies     []string
+	IngressAllowedPods *int
+	EgressAllowedPods  *int
+}
+
+func PodIsolation() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_networking_pod_isolation",
+		Description:  "Ingress and egress isolation of each pod, computed by evaluating the pod and namespace selectors of the network policies against the pods and namespaces of the context. Pods using the host network and pods that have finished are skipped, network policies don't apply to them.",
+		Resolver:     fetchNetworkingPodIsolation,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "pod_uid",
+				Description: "UID of the pod.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("PodUID"),
+			},
+			{
+				Name:        "pod_name",
+				Description: "Name of the pod.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace of the pod.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "ingress_isolated",
+				Description: "Whether the pod is isolated for ingress, i.e. selected by at least one network policy applying to ingress traffic.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "egress_isolated",
+				Description: "Whether the pod is isolated for egress, i.e. selected by at least one network policy applying to egress traffic.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:          "ingress_policies",
+				Description:   "Names of the network policies isolating the pod for ingress.",
+				Type:          schema.TypeStringArray,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "egress_policies",
+				Description:   "Names of the network policies isolating the pod for egress.",
+				Type:          schema.TypeStringArray,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "ingress_allowed_pods",
+				Description:   "Number of pods of the context, other than the skipped ones, allowed to connect to the pod by the ingress rules of the policies isolating it, ignoring ports. Pods are allowed by pod and namespace selectors and by IP blocks containing their IP. Null if the pod isn't isolated for ingress.",
+				Type:          schema.TypeInt,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "egress_allowed_pods",
+				Description:   "Number of pods of the context, other than the skipped ones, the pod is allowed to connect to by the egress rules of the policies isolating it, ignoring ports. Pods are allowed by pod and namespace selectors and by IP blocks containing their IP. Null if the pod isn't isolated for egress.",
+				Type:          schema.TypeInt,
+				IgnoreInTests: true,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchNetworkingPodIsolation(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c, err := fetchPolicyContext(ctx, meta.(*client.Client).Services())
+	if err != nil {
+		return err
+	}
+	res <- c.podIsolation()
+	return nil
+}
+
+// podIsolation evaluates the network policies of the context against each of its isolatable pods.
+func (c *policyContext) podIsolation() []podIsolation {
+	pods := make([]corev1.Pod, 0, len(c.Pods))
+	for _, pod := range c.Pods {
+		if isolatable(pod) {
+			pods = append(pods, pod)
+		}
+	}
+	// the pods allowed by the rules of a policy don't depend on the pod it selects, so they are computed once
+	// per policy the first time a pod selected by it is evaluated
+	policies := c.compiledPolicies()
+	ingressAllowed := make(map[int][]bool)
+	egressAllowed := make(map[int][]bool)
+	allowed := func(cache map[int][]bool, i int, rules []compiledRule) []bool {
+		if a, ok := cache[i]; ok {
+			return a
+		}
+		a := make([]bool, len(pods))
+		for j, pod := range pods {
+			for _, r := range rules {
+				if c.peersSelectPod(policies[i].Namespace, r.peers, pod) {
+					a[j] = true
+					break
+				}
+			}
+		}
+		cache[i] = a
+		return a
+	}
+
+	isolation := make([]podIsolation, 0, len(pods))
+	for _, pod := range pods {
+		iso := podIsolation{
+			PodUID:    string(pod.UID),
+			PodName:   pod.Name,
+			Namespace: pod.Namespace,
+		}
+		var ingress, egress [][]bool
+		for i, p := range policies {
+			if !p.selectsPod(pod) {
+				continue
+			}
+			if p.ingress {
+				iso.IngressIsolated = true
+				iso.IngressPolicies = append(iso.IngressPolicies, p.Name)
+				ingress = append(ingress, allowed(ingressAllowed, i, p.ingressRules))
+			}
+			if p.egress {
+				iso.EgressIsolated = true
+				iso.EgressPolicies = append(iso.EgressPolicies, p.Name)
+				egress = append(egress, allowed(egressAllowed, i, p.egressRules))
+			}
+		}
+		if iso.IngressIsolated {
+			n := countAllowed(ingress, len(pods))
+			iso.IngressAllowedPods = &n
+		}
+		if iso.EgressIsolated {
+			n := countAllowed(egress, len(pods))
+			iso.EgressAllowedPods = &n
+		}
+		isolation = append(isolation, iso)
+	}
+	return isolation
+}
+
+// countAllowed counts the pods allowed by any of the given policies, policies isolating a pod are additive.
+func countAllowed(allowed [][]bool, pods int) int {
+	n := 0
+	for j := 0; j < pods; j++ {
+		for _, a := range allowed {
+			if a[j] {
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationPodIsolation(t *testing.T) {
+	client.K8sTestHelper(t, PodIsolation(), "./snapshots")
+}
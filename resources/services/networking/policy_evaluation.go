@@ -0,0 +1,263 @@
+package networking
+
+import (
+	"context"
+	"net"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// policyContext holds the network policies, namespaces and pods of a single context, which network policies are
+// evaluated against.
+type policyContext struct {
+	Policies []networkingv1.NetworkPolicy
+	Pods     []corev1.Pod
+	// NamespaceLabels holds the labels of each namespace by namespace name.
+	NamespaceLabels map[string]labels.Set
+
+	compiled []compiledPolicy
+}
+
+// compiledPolicy is a network policy with its selectors and IP blocks parsed, so that they are parsed once rather
+// than for every pod the policy is evaluated against.
+type compiledPolicy struct {
+	networkingv1.NetworkPolicy
+	podSelector     labels.Selector
+	ingress, egress bool
+	ingressRules    []compiledRule
+	egressRules     []compiledRule
+}
+
+// compiledRule is an ingress or egress rule of a compiled policy.
+type compiledRule struct {
+	ports []networkingv1.NetworkPolicyPort
+	peers []compiledPeer
+}
+
+// compiledPeer is a peer of a compiled rule. Peers without a namespace selector select pods in the namespace of the
+// policy and peers without a pod selector select all pods of the selected namespaces.
+type compiledPeer struct {
+	namespaceSelector labels.Selector
+	podSelector       labels.Selector
+	ipBlock           *ipBlock
+}
+
+// ipBlock is a parsed IP block peer, blocks that can't be parsed contain no IPs.
+type ipBlock struct {
+	networkingv1.IPBlock
+	cidr   *net.IPNet
+	except []*net.IPNet
+}
+
+func fetchPolicyContext(ctx context.Context, svc client.Services) (*policyContext, error) {
+	var (
+		c   = policyContext{NamespaceLabels: make(map[string]labels.Set)}
+		err error
+	)
+	if c.Policies, err = client.ListAll(ctx, svc.NetworkPolicies.List, func(l *networkingv1.NetworkPolicyList) []networkingv1.NetworkPolicy { return l.Items }); err != nil {
+		return nil, err
+	}
+	if c.Pods, err = client.ListAll(ctx, svc.Pods.List, func(l *corev1.PodList) []corev1.Pod { return l.Items }); err != nil {
+		return nil, err
+	}
+	namespaces, err := client.ListAll(ctx, svc.Namespaces.List, func(l *corev1.NamespaceList) []corev1.Namespace { return l.Items })
+	if err != nil {
+		return nil, err
+	}
+	for _, ns := range namespaces {
+		c.NamespaceLabels[ns.Name] = ns.Labels
+	}
+	return &c, nil
+}
+
+// isolatable reports whether network policies apply to a pod. Pods using the host network share the network
+// namespace of their node and pods that have finished no longer have a network, so policies don't apply to them.
+func isolatable(pod corev1.Pod) bool {
+	return !pod.Spec.HostNetwork && pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
+// compiledPolicies returns the policies of the context with their selectors parsed, parsing them on first use.
+func (c *policyContext) compiledPolicies() []compiledPolicy {
+	if c.compiled != nil {
+		return c.compiled
+	}
+	c.compiled = make([]compiledPolicy, 0, len(c.Policies))
+	for _, p := range c.Policies {
+		cp := compiledPolicy{NetworkPolicy: p, podSelector: selector(&p.Spec.PodSelector)}
+		cp.ingress, cp.egress = policyTypes(p)
+		for _, r := range p.Spec.Ingress {
+			cp.ingressRules = append(cp.ingressRules, compileRule(r.Ports, r.From))
+		}
+		for _, r := range p.Spec.Egress {
+			cp.egressRules = append(cp.egressRules, compileRule(r.Ports, r.To))
+		}
+		c.compiled = append(c.compiled, cp)
+	}
+	return c.compiled
+}
+
+func compileRule(ports []networkingv1.NetworkPolicyPort, peers []networkingv1.NetworkPolicyPeer) compiledRule {
+	r := compiledRule{ports: ports, peers: make([]compiledPeer, 0, len(peers))}
+	for _, peer := range peers {
+		var cp compiledPeer
+		if peer.IPBlock != nil {
+			cp.ipBlock = newIPBlock(*peer.IPBlock)
+		}
+		if peer.NamespaceSelector != nil {
+			cp.namespaceSelector = selector(peer.NamespaceSelector)
+		}
+		if peer.PodSelector != nil {
+			cp.podSelector = selector(peer.PodSelector)
+		}
+		r.peers = append(r.peers, cp)
+	}
+	return r
+}
+
+func newIPBlock(b networkingv1.IPBlock) *ipBlock {
+	block := &ipBlock{IPBlock: b}
+	if _, cidr, err := net.ParseCIDR(b.CIDR); err == nil {
+		block.cidr = cidr
+	}
+	for _, e := range b.Except {
+		if _, except, err := net.ParseCIDR(e); err == nil {
+			block.except = append(block.except, except)
+		}
+	}
+	return block
+}
+
+// policyTypes returns whether a policy applies to ingress and to egress traffic. Policies that don't list their
+// types apply to ingress, and to egress if they have egress rules.
+func policyTypes(p networkingv1.NetworkPolicy) (ingress bool, egress bool) {
+	if len(p.Spec.PolicyTypes) == 0 {
+		return true, len(p.Spec.Egress) > 0
+	}
+	for _, t := range p.Spec.PolicyTypes {
+		switch t {
+		case networkingv1.PolicyTypeIngress:
+			ingress = true
+		case networkingv1.PolicyTypeEgress:
+			egress = true
+		}
+	}
+	return ingress, egress
+}
+
+// selectsPod reports whether a policy applies to a pod, i.e. whether the pod is in the namespace of the policy and
+// matches its pod selector.
+func (p compiledPolicy) selectsPod(pod corev1.Pod) bool {
+	return p.Namespace == pod.Namespace && p.podSelector.Matches(labels.Set(pod.Labels))
+}
+
+// peerSelectsPod reports whether a peer of a rule of a policy in the given namespace selects a pod. IP block peers
+// select the pods with an IP in the block.
+func (c *policyContext) peerSelectsPod(policyNamespace string, peer compiledPeer, pod corev1.Pod) bool {
+	if peer.ipBlock != nil {
+		return peer.ipBlock.containsPod(pod)
+	}
+	if peer.namespaceSelector == nil {
+		if pod.Namespace != policyNamespace {
+			return false
+		}
+	} else if !peer.namespaceSelector.Matches(c.NamespaceLabels[pod.Namespace]) {
+		return false
+	}
+	return peer.podSelector == nil || peer.podSelector.Matches(labels.Set(pod.Labels))
+}
+
+// peersSelectPod reports whether the peers of a rule select a pod. Rules without peers select all pods.
+func (c *policyContext) peersSelectPod(policyNamespace string, peers []compiledPeer, pod corev1.Pod) bool {
+	if len(peers) == 0 {
+		return true
+	}
+	for _, peer := range peers {
+		if c.peerSelectsPod(policyNamespace, peer, pod) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsPod reports whether one of the IPs of a pod is in the IP block and not in its exceptions.
+func (b *ipBlock) containsPod(pod corev1.Pod) bool {
+	if b.cidr == nil {
+		return false
+	}
+	ips := []string{pod.Status.PodIP}
+	for _, ip := range pod.Status.PodIPs {
+		ips = append(ips, ip.IP)
+	}
+	for _, s := range ips {
+		ip := net.ParseIP(s)
+		if ip == nil || !b.cidr.Contains(ip) {
+			continue
+		}
+		excluded := false
+		for _, e := range b.except {
+			if e.Contains(ip) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			return true
+		}
+	}
+	return false
+}
+
+// portMatches reports whether the ports of a rule allow traffic to a container port. Rules without ports allow all
+// ports, named ports match container ports by name and numbered ports match the range up to their end port.
+func portMatches(ports []networkingv1.NetworkPolicyPort, port corev1.ContainerPort) bool {
+	if len(ports) == 0 {
+		return true
+	}
+	for _, p := range ports {
+		protocol := corev1.ProtocolTCP
+		if p.Protocol != nil {
+			protocol = portProtocol(*p.Protocol)
+		}
+		if protocol != portProtocol(port.Protocol) {
+			continue
+		}
+		switch {
+		case p.Port == nil:
+			return true
+		case p.Port.Type == intstr.String:
+			if port.Name != "" && p.Port.StrVal == port.Name {
+				return true
+			}
+		case p.EndPort == nil:
+			if p.Port.IntVal == port.ContainerPort {
+				return true
+			}
+		default:
+			if p.Port.IntVal <= port.ContainerPort && port.ContainerPort <= *p.EndPort {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func portProtocol(p corev1.Protocol) corev1.Protocol {
+	if p == "" {
+		return corev1.ProtocolTCP
+	}
+	return p
+}
+
+// selector converts a label selector, selectors that can't be parsed select nothing.
+func selector(s *metav1.LabelSelector) labels.Selector {
+	sel, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return labels.Nothing()
+	}
+	return sel
+}
@@ -0,0 +1,238 @@
+package networking
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPodIsolation(t *testing.T) {
+	pod := func(namespace, name string, l map[string]string) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID("uid-" + name), Labels: l}}
+	}
+	// network policies don't apply to pods on the host network or pods that have finished
+	hostNetwork := pod("default", "node-agent", map[string]string{"app": "web"})
+	hostNetwork.Spec.HostNetwork = true
+	completed := pod("default", "migrate", map[string]string{"app": "api"})
+	completed.Status.Phase = corev1.PodSucceeded
+	// IP block peers select the pods with an IP in the block
+	db := pod("default", "db", map[string]string{"app": "db"})
+	db.Status.PodIP = "10.1.2.3"
+	c := policyContext{
+		NamespaceLabels: map[string]labels.Set{
+			"default":    {"name": "default"},
+			"monitoring": {"name": "monitoring"},
+		},
+		Pods: []corev1.Pod{
+			pod("default", "web", map[string]string{"app": "web"}),
+			pod("default", "api", map[string]string{"app": "api"}),
+			db,
+			pod("monitoring", "prometheus", map[string]string{"app": "prometheus"}),
+			hostNetwork,
+			completed,
+		},
+		Policies: []networkingv1.NetworkPolicy{
+			{
+				// allows ingress to the api from the web pods and from any pod of the monitoring namespace
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"api"}},
+					}},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{From: []networkingv1.NetworkPolicyPeer{
+						{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+						{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "monitoring"}}},
+						{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}},
+					}}},
+				},
+			},
+			{
+				// denies all egress of the namespace
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "default-deny-egress"},
+				Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+			},
+			{
+				// allows egress of the web pods to any pod of the namespace
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-egress"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+					Egress:      []networkingv1.NetworkPolicyEgressRule{{To: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}}},
+				},
+			},
+		},
+	}
+
+	type want struct {
+		ingressPolicies, egressPolicies int
+		ingressAllowed, egressAllowed   int
+	}
+	expected := map[string]want{
+		"web":        {egressPolicies: 2, ingressAllowed: -1, egressAllowed: 3},
+		"api":        {ingressPolicies: 1, egressPolicies: 1, ingressAllowed: 3, egressAllowed: 0},
+		"db":         {egressPolicies: 1, ingressAllowed: -1, egressAllowed: 0},
+		"prometheus": {ingressAllowed: -1, egressAllowed: -1},
+	}
+	count := func(n *int) int {
+		if n == nil {
+			return -1
+		}
+		return *n
+	}
+	isolation := c.podIsolation()
+	if len(isolation) != len(expected) {
+		t.Fatalf("expected %d pods, got %+v", len(expected), isolation)
+	}
+	for _, iso := range isolation {
+		w := expected[iso.PodName]
+		if len(iso.IngressPolicies) != w.ingressPolicies || iso.IngressIsolated != (w.ingressPolicies > 0) ||
+			len(iso.EgressPolicies) != w.egressPolicies || iso.EgressIsolated != (w.egressPolicies > 0) {
+			t.Errorf("%s: unexpected policies %+v", iso.PodName, iso)
+		}
+		if count(iso.IngressAllowedPods) != w.ingressAllowed || count(iso.EgressAllowedPods) != w.egressAllowed {
+			t.Errorf("%s: expected %d ingress and %d egress allowed pods, got %d and %d", iso.PodName,
+				w.ingressAllowed, w.egressAllowed, count(iso.IngressAllowedPods), count(iso.EgressAllowedPods))
+		}
+	}
+}
+
+func TestPortMatches(t *testing.T) {
+	udp := corev1.ProtocolUDP
+	port := func(p int) *intstr.IntOrString {
+		v := intstr.FromInt(p)
+		return &v
+	}
+	named := intstr.FromString("http")
+	endPort := int32(8090)
+
+	cases := []struct {
+		name  string
+		ports []networkingv1.NetworkPolicyPort
+		port  corev1.ContainerPort
+		want  bool
+	}{
+		{name: "all ports", port: corev1.ContainerPort{ContainerPort: 8080}, want: true},
+		{name: "all tcp ports", ports: []networkingv1.NetworkPolicyPort{{}}, port: corev1.ContainerPort{ContainerPort: 8080}, want: true},
+		{name: "protocol mismatch", ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp}}, port: corev1.ContainerPort{ContainerPort: 8080}},
+		{name: "number", ports: []networkingv1.NetworkPolicyPort{{Port: port(8080)}}, port: corev1.ContainerPort{ContainerPort: 8080}, want: true},
+		{name: "number mismatch", ports: []networkingv1.NetworkPolicyPort{{Port: port(8081)}}, port: corev1.ContainerPort{ContainerPort: 8080}},
+		{name: "range", ports: []networkingv1.NetworkPolicyPort{{Port: port(8000), EndPort: &endPort}}, port: corev1.ContainerPort{ContainerPort: 8080}, want: true},
+		{name: "out of range", ports: []networkingv1.NetworkPolicyPort{{Port: port(8000), EndPort: &endPort}}, port: corev1.ContainerPort{ContainerPort: 9090}},
+		{name: "named", ports: []networkingv1.NetworkPolicyPort{{Port: &named}}, port: corev1.ContainerPort{Name: "http", ContainerPort: 8080}, want: true},
+		{name: "named mismatch", ports: []networkingv1.NetworkPolicyPort{{Port: &named}}, port: corev1.ContainerPort{Name: "metrics", ContainerPort: 8080}},
+	}
+	for _, tc := range cases {
+		if got := portMatches(tc.ports, tc.port); got != tc.want {
+			t.Errorf("%s: portMatches() = %v, expected %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestIPBlockContainsPod(t *testing.T) {
+	block := networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}
+	cases := map[string]bool{
+		"10.2.3.4":  true,
+		"10.1.2.3":  false,
+		"192.0.2.1": false,
+		"":          false,
+	}
+	for ip, want := range cases {
+		pod := corev1.Pod{Status: corev1.PodStatus{PodIP: ip}}
+		if got := newIPBlock(block).containsPod(pod); got != want {
+			t.Errorf("%s: containsPod() = %v, expected %v", ip, got, want)
+		}
+	}
+}
+
+func TestReachability(t *testing.T) {
+	pod := func(name, app, ip string, ports ...corev1.ContainerPort) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: types.UID(name), Labels: map[string]string{"app": app}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Ports: ports}}},
+			Status:     corev1.PodStatus{PodIP: ip},
+		}
+	}
+	// policies don't apply to host network and finished pods, so they are neither sources nor destinations
+	agent := pod("agent", "agent", "192.168.0.10", corev1.ContainerPort{ContainerPort: 9100})
+	agent.Spec.HostNetwork = true
+	migrate := pod("migrate", "migrate", "10.0.0.4", corev1.ContainerPort{ContainerPort: 5432})
+	migrate.Status.Phase = corev1.PodSucceeded
+	http := intstr.FromString("http")
+	https := intstr.FromInt(443)
+	c := policyContext{
+		NamespaceLabels: map[string]labels.Set{"default": {}},
+		Pods: []corev1.Pod{
+			pod("web-1", "web", "10.0.0.1"),
+			pod("web-2", "web", "10.0.0.3"),
+			pod("batch", "batch", "10.1.0.1"),
+			pod("api", "api", "10.0.0.2", corev1.ContainerPort{Name: "http", ContainerPort: 8080}, corev1.ContainerPort{ContainerPort: 9090}),
+			agent,
+			migrate,
+		},
+		Policies: []networkingv1.NetworkPolicy{
+			{
+				// allows ingress to the http port of the api from the 10.0.0.0/16 block
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{
+						Ports: []networkingv1.NetworkPolicyPort{{Port: &http}},
+						From:  []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/16"}}},
+					}},
+				},
+			},
+			{
+				// allows egress of the web pods to the api and to https outside the cluster
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+					Egress: []networkingv1.NetworkPolicyEgressRule{
+						{To: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}}}},
+						{
+							Ports: []networkingv1.NetworkPolicyPort{{Port: &https}},
+							To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: []string{"10.0.0.0/8"}}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	workloadOf := func(pod corev1.Pod) workload {
+		return workload{Kind: "Deployment", Name: pod.Labels["app"], UID: pod.Labels["app"]}
+	}
+	if classes := c.podClasses(workloadOf); len(classes) != 3 || classes[0].Size != 2 {
+		t.Fatalf("expected the web pods to share a class and no class for host network and finished pods, got %+v", classes)
+	}
+	allowed := c.reachability(workloadOf)
+	got := make(map[string]bool, len(allowed))
+	for _, r := range allowed {
+		endpoint := func(kind, name string) string {
+			if kind == ipBlockKind {
+				return r.CIDR
+			}
+			return name
+		}
+		got[fmt.Sprintf("%s -> %s:%d/%s", endpoint(r.SourceKind, r.SourceName), endpoint(r.DestinationKind, r.DestinationName), *r.Port, r.Protocol)] = true
+	}
+	want := []string{
+		"web -> api:8080/TCP",
+		"10.0.0.0/16 -> api:8080/TCP",
+		"web -> 0.0.0.0/0:443/TCP",
+	}
+	if len(allowed) != len(want) {
+		t.Fatalf("expected %d allowed connections, got %+v", len(want), allowed)
+	}
+	for _, w := range want {
+		if !got[w] {
+			t.Errorf("expected allowed connection %q, got %v", w, got)
+		}
+	}
+}
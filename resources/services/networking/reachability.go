@@ -0,0 +1,418 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ipBlockKind is the kind of the sources and destinations of allowed connections that are IP blocks of the rules of
+// network policies rather than workloads.
+const ipBlockKind = "IPBlock"
+
+// workload is the top-level owner of a pod, or the pod itself if it has no owner.
+type workload struct {
+	Kind string
+	Name string
+	UID  string
+}
+
+// reachability is a port of a destination workload or IP block a source workload or IP block is allowed to
+// connect to.
+type reachability struct {
+	SourceNamespace      string
+	SourceKind           string
+	SourceName           string
+	SourceUID            string
+	DestinationNamespace string
+	DestinationKind      string
+	DestinationName      string
+	DestinationUID       string
+	CIDR                 string
+	CIDRExcept           []string
+	Port                 *int32
+	EndPort              *int32
+	PortName             string
+	Protocol             string
+}
+
+// podClass is a set of pods network policies can't tell apart: pods of the same workload and namespace with the
+// same labels, container ports and IPs in the same IP blocks of the policies.
+type podClass struct {
+	Pod      corev1.Pod
+	Workload workload
+	Size     int
+}
+
+func Reachability() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_network_reachability",
+		Description:  "Ports of workloads and IP blocks that workloads and IP blocks are allowed to connect to, computed by evaluating the ingress and egress rules of the network policies, including IP blocks and port ranges, against the pods of the context. Pods using the host network and pods that have finished aren't evaluated. The IP blocks of the rules of policies isolating a pod are listed as sources and destinations of kind IPBlock; connections from outside the cluster to pods that aren't isolated aren't listed.",
+		Resolver:     fetchNetworkReachability,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "source_namespace",
+				Description: "Namespace of the source workload, empty for IP blocks.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "source_kind",
+				Description: "Kind of the source workload, Pod for pods without owner and IPBlock for IP blocks.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "source_name",
+				Description: "Name of the source workload, empty for IP blocks.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "source_uid",
+				Description: "UID of the source workload, empty for IP blocks.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("SourceUID"),
+			},
+			{
+				Name:        "destination_namespace",
+				Description: "Namespace of the destination workload, empty for IP blocks.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "destination_kind",
+				Description: "Kind of the destination workload, Pod for pods without owner and IPBlock for IP blocks.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "destination_name",
+				Description: "Name of the destination workload, empty for IP blocks.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "destination_uid",
+				Description: "UID of the destination workload, empty for IP blocks.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("DestinationUID"),
+			},
+			{
+				Name:        "cidr",
+				Description: "CIDR of the source or destination of kind IPBlock, null for connections between workloads.",
+				Type:        schema.TypeCIDR,
+				Resolver:    resolveNetworkReachabilityCIDR,
+			},
+			{
+				Name:          "cidr_except",
+				Description:   "CIDRs excluded from the IP block.",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("CIDRExcept"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "port",
+				Description: "Container port of the destination workload, or port allowed by the egress rule for IP block destinations. Null if the egress rule allows all ports.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:          "end_port",
+				Description:   "Last port of the range allowed by the egress rule for IP block destinations, null for single ports.",
+				Type:          schema.TypeInt,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "port_name",
+				Description:   "Name of the container port, or named port allowed by the egress rule for IP block destinations. Empty if the port is unnamed.",
+				Type:          schema.TypeString,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "protocol",
+				Description: "IP protocol of the port: TCP, UDP or SCTP. Empty if the egress rule allows all ports and protocols.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchNetworkReachability(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client)
+	c, err := fetchPolicyContext(ctx, cl.Services())
+	if err != nil {
+		return err
+	}
+	index, err := cl.OwnerIndex(ctx)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	res <- c.reachability(func(pod corev1.Pod) workload {
+		if root, ok := index.Root(pod.OwnerReferences); ok {
+			return workload{Kind: root.Kind, Name: root.Name, UID: string(root.UID)}
+		}
+		return workload{Kind: "Pod", Name: pod.Name, UID: string(pod.UID)}
+	})
+	return nil
+}
+
+func resolveNetworkReachabilityCIDR(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(reachability)
+	if r.CIDR == "" {
+		return nil
+	}
+	_, n, err := net.ParseCIDR(r.CIDR)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, n))
+}
+
+// reachability evaluates the network policies of the context for each pair of pod classes and each container port
+// of the destination pods. Traffic is allowed if the egress of the source and the ingress of the destination are
+// either not isolated or allowed by one of the policies isolating them. Only ports declared by the containers of
+// the destination pods are evaluated, and the allowed connections are aggregated by workload. The IP blocks of the
+// rules isolating a pod are evaluated as sources of its ingress and destinations of its egress.
+func (c *policyContext) reachability(workloadOf func(pod corev1.Pod) workload) []reachability {
+	policies := c.compiledPolicies()
+	classes := c.podClasses(workloadOf)
+	ingress := make([][]int, len(classes))
+	egress := make([][]int, len(classes))
+	for j, class := range classes {
+		for i, p := range policies {
+			if !p.selectsPod(class.Pod) {
+				continue
+			}
+			if p.ingress {
+				ingress[j] = append(ingress[j], i)
+			}
+			if p.egress {
+				egress[j] = append(egress[j], i)
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	var allowed []reachability
+	add := func(r reachability) {
+		if key := r.key(); !seen[key] {
+			seen[key] = true
+			allowed = append(allowed, r)
+		}
+	}
+	for d, dst := range classes {
+		for _, port := range containerPorts(dst.Pod) {
+			for s, src := range classes {
+				// a pod doesn't connect to itself through the network, but to the other pods of its class
+				if s == d && dst.Size == 1 {
+					continue
+				}
+				if !c.egressAllowed(egress[s], dst.Pod, port) || !c.ingressAllowed(ingress[d], src.Pod, port) {
+					continue
+				}
+				r := newReachability(src, dst)
+				r.Port, r.PortName, r.Protocol = int32Ptr(port.ContainerPort), port.Name, string(portProtocol(port.Protocol))
+				add(r)
+			}
+		}
+	}
+	for d, dst := range classes {
+		for _, i := range ingress[d] {
+			for _, rule := range policies[i].ingressRules {
+				for _, peer := range rule.peers {
+					// blocks that can't be parsed allow nothing
+					if peer.ipBlock == nil || peer.ipBlock.cidr == nil {
+						continue
+					}
+					for _, port := range containerPorts(dst.Pod) {
+						if !portMatches(rule.ports, port) {
+							continue
+						}
+						r := newReachability(podClass{}, dst)
+						r.SourceKind, r.CIDR, r.CIDRExcept = ipBlockKind, peer.ipBlock.CIDR, peer.ipBlock.Except
+						r.Port, r.PortName, r.Protocol = int32Ptr(port.ContainerPort), port.Name, string(portProtocol(port.Protocol))
+						add(r)
+					}
+				}
+			}
+		}
+	}
+	for s, src := range classes {
+		for _, i := range egress[s] {
+			for _, rule := range policies[i].egressRules {
+				for _, peer := range rule.peers {
+					// blocks that can't be parsed allow nothing
+					if peer.ipBlock == nil || peer.ipBlock.cidr == nil {
+						continue
+					}
+					for _, port := range rulePorts(rule.ports) {
+						r := newReachability(src, podClass{})
+						r.DestinationKind, r.CIDR, r.CIDRExcept = ipBlockKind, peer.ipBlock.CIDR, peer.ipBlock.Except
+						r.Port, r.EndPort, r.PortName, r.Protocol = port.Port, port.EndPort, port.PortName, port.Protocol
+						add(r)
+					}
+				}
+			}
+		}
+	}
+	return allowed
+}
+
+// podClasses groups the isolatable pods of the context into classes, so that pods network policies can't tell
+// apart, such as the replicas of a workload, are evaluated once.
+func (c *policyContext) podClasses(workloadOf func(pod corev1.Pod) workload) []podClass {
+	var blocks []*ipBlock
+	for _, p := range c.compiledPolicies() {
+		for _, rules := range [][]compiledRule{p.ingressRules, p.egressRules} {
+			for _, r := range rules {
+				for _, peer := range r.peers {
+					if peer.ipBlock != nil {
+						blocks = append(blocks, peer.ipBlock)
+					}
+				}
+			}
+		}
+	}
+
+	index := make(map[string]int)
+	var classes []podClass
+	for _, pod := range c.Pods {
+		if !isolatable(pod) {
+			continue
+		}
+		w := workloadOf(pod)
+		var key strings.Builder
+		fmt.Fprintf(&key, "%s/%s/%s/%s/%v/", w.Kind, w.UID, pod.Namespace, labels.Set(pod.Labels), containerPorts(pod))
+		for _, b := range blocks {
+			if b.containsPod(pod) {
+				key.WriteByte('1')
+			} else {
+				key.WriteByte('0')
+			}
+		}
+		if i, ok := index[key.String()]; ok {
+			classes[i].Size++
+			continue
+		}
+		index[key.String()] = len(classes)
+		classes = append(classes, podClass{Pod: pod, Workload: w, Size: 1})
+	}
+	return classes
+}
+
+// ingressAllowed reports whether the given ingress policies of a pod allow traffic from the source pod to the port,
+// pods without ingress policies aren't isolated for ingress.
+func (c *policyContext) ingressAllowed(policies []int, src corev1.Pod, port corev1.ContainerPort) bool {
+	if len(policies) == 0 {
+		return true
+	}
+	for _, i := range policies {
+		p := c.compiledPolicies()[i]
+		for _, r := range p.ingressRules {
+			if portMatches(r.ports, port) && c.peersSelectPod(p.Namespace, r.peers, src) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// egressAllowed reports whether the given egress policies of a pod allow traffic to the port of the destination
+// pod, pods without egress policies aren't isolated for egress.
+func (c *policyContext) egressAllowed(policies []int, dst corev1.Pod, port corev1.ContainerPort) bool {
+	if len(policies) == 0 {
+		return true
+	}
+	for _, i := range policies {
+		p := c.compiledPolicies()[i]
+		for _, r := range p.egressRules {
+			if portMatches(r.ports, port) && c.peersSelectPod(p.Namespace, r.peers, dst) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newReachability returns a connection from the workload of the source class to the workload of the destination
+// class, empty classes leave the source or destination fields empty.
+func newReachability(src, dst podClass) reachability {
+	return reachability{
+		SourceNamespace:      src.Pod.Namespace,
+		SourceKind:           src.Workload.Kind,
+		SourceName:           src.Workload.Name,
+		SourceUID:            src.Workload.UID,
+		DestinationNamespace: dst.Pod.Namespace,
+		DestinationKind:      dst.Workload.Kind,
+		DestinationName:      dst.Workload.Name,
+		DestinationUID:       dst.Workload.UID,
+	}
+}
+
+// key identifies a connection, connections allowed by several policies or pods are listed once.
+func (r reachability) key() string {
+	port, endPort := int32(0), int32(0)
+	if r.Port != nil {
+		port = *r.Port
+	}
+	if r.EndPort != nil {
+		endPort = *r.EndPort
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d/%d/%s/%s", r.SourceKind, r.SourceUID, r.DestinationKind, r.DestinationUID,
+		r.CIDR, strings.Join(r.CIDRExcept, ","), port, endPort, r.PortName, r.Protocol)
+}
+
+// rulePort is a port allowed by an egress rule to an IP block, whose ports aren't known.
+type rulePort struct {
+	Port     *int32
+	EndPort  *int32
+	PortName string
+	Protocol string
+}
+
+// rulePorts returns the ports allowed by the ports of a rule. Rules without ports allow all ports and protocols,
+// ports without a port number allow all ports of their protocol.
+func rulePorts(ports []networkingv1.NetworkPolicyPort) []rulePort {
+	if len(ports) == 0 {
+		return []rulePort{{}}
+	}
+	allowed := make([]rulePort, 0, len(ports))
+	for _, p := range ports {
+		port := rulePort{Protocol: string(corev1.ProtocolTCP), EndPort: p.EndPort}
+		if p.Protocol != nil {
+			port.Protocol = string(portProtocol(*p.Protocol))
+		}
+		switch {
+		case p.Port == nil:
+		case p.Port.Type == intstr.String:
+			port.PortName = p.Port.StrVal
+		default:
+			port.Port = int32Ptr(p.Port.IntVal)
+		}
+		allowed = append(allowed, port)
+	}
+	return allowed
+}
+
+func containerPorts(pod corev1.Pod) []corev1.ContainerPort {
+	var ports []corev1.ContainerPort
+	for _, c := range pod.Spec.Containers {
+		ports = append(ports, c.Ports...)
+	}
+	return ports
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,62 @@
+//go:build mock
+// +build mock
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createNetworkReachability(t *testing.T, ctrl *gomock.Controller) client.Services {
+	pod := k8sTesting.FakePod(t)
+	pod.Namespace = "default"
+	pod.Labels = map[string]string{"app": "web"}
+	pod.Spec.HostNetwork = false
+	pod.Status.Phase = corev1.PodRunning
+	pod.Spec.Containers[0].Ports = []corev1.ContainerPort{{Name: "http", ContainerPort: 8080, Protocol: corev1.ProtocolTCP}}
+	source := k8sTesting.FakePod(t)
+	source.Namespace = "default"
+	source.Spec.HostNetwork = false
+	source.Status.Phase = corev1.PodRunning
+	policy := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{From: []networkingv1.NetworkPolicyPeer{
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "default"}}},
+				{IPBlock: &networkingv1.IPBlock{CIDR: "192.0.2.0/24"}},
+			}}},
+		},
+	}
+	namespace := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"name": "default"}}}
+
+	networkPolicies := mocks.NewMockNetworkPoliciesClient(ctrl)
+	networkPolicies.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&networkingv1.NetworkPolicyList{Items: []networkingv1.NetworkPolicy{policy}}, nil,
+	)
+	pods := mocks.NewMockPodsClient(ctrl)
+	pods.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.PodList{Items: []corev1.Pod{pod, source}}, nil,
+	)
+	namespaces := mocks.NewMockNamespacesClient(ctrl)
+	namespaces.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&corev1.NamespaceList{Items: []corev1.Namespace{namespace}}, nil,
+	)
+	return client.Services{
+		NetworkPolicies: networkPolicies,
+		Pods:            pods,
+		Namespaces:      namespaces,
+	}
+}
+
+func TestNetworkReachability(t *testing.T) {
+	client.K8sMockTestHelper(t, Reachability(), createNetworkReachability, client.TestOptions{})
+}
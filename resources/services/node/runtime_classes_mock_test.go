@@ -0,0 +1,50 @@
+//go:build mock
+// +build mock
+
+package node
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createRuntimeClasses(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockRuntimeClassesClient(ctrl)
+	m.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&nodev1.RuntimeClassList{Items: []nodev1.RuntimeClass{fakeRuntimeClass(t)}}, nil,
+	)
+	return client.Services{
+		RuntimeClasses: m,
+	}
+}
+
+func fakeRuntimeClass(t *testing.T) nodev1.RuntimeClass {
+	var rc nodev1.RuntimeClass
+	k8sTesting.FakeThroughPointers(t,
+		&rc.TypeMeta,
+		&rc.ObjectMeta,
+		&rc.Handler,
+	)
+	rc.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	rc.Overhead = &nodev1.Overhead{PodFixed: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("250m"),
+		corev1.ResourceMemory: resource.MustParse("120Mi"),
+	}}
+	rc.Scheduling = &nodev1.Scheduling{
+		NodeSelector: map[string]string{"runtime": "gvisor"},
+		Tolerations:  []corev1.Toleration{{Key: "runtime", Operator: corev1.TolerationOpEqual, Value: "gvisor", Effect: corev1.TaintEffectNoSchedule}},
+	}
+	return rc
+}
+
+func TestRuntimeClasses(t *testing.T) {
+	client.K8sMockTestHelper(t, RuntimeClasses(), createRuntimeClasses, client.TestOptions{})
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package node
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationRuntimeClasses(t *testing.T) {
+	client.K8sTestHelper(t, RuntimeClasses(), "./snapshots")
+}
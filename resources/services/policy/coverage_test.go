@@ -0,0 +1,46 @@
+package policy
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCoveredWorkloads(t *testing.T) {
+	isController := true
+	controlledBy := func(kind, name string, uid types.UID) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: name, UID: uid, Controller: &isController}}
+	}
+	rs := appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web-5d8f", UID: "rs", OwnerReferences: controlledBy("Deployment", "web", "deploy")}}
+	ready := corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}}
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-5d8f-a", UID: "a", OwnerReferences: controlledBy("ReplicaSet", "web-5d8f", "rs")}, Status: ready},
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-5d8f-b", UID: "b", OwnerReferences: controlledBy("ReplicaSet", "web-5d8f", "rs")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-0", UID: "c", OwnerReferences: controlledBy("StatefulSet", "db", "sts")}, Status: ready},
+		{ObjectMeta: metav1.ObjectMeta{Name: "debug", UID: "d"}},
+	}
+
+	root := func(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+		if refs[0].UID == rs.UID {
+			return rs.OwnerReferences[0], true
+		}
+		return refs[0], true
+	}
+	got := coveredWorkloads(pods, root)
+	want := []coveredWorkload{
+		{Kind: "Deployment", Name: "web", UID: "deploy", Pods: 2, ReadyPods: 1},
+		{Kind: "StatefulSet", Name: "db", UID: "sts", Pods: 1, ReadyPods: 1},
+		{Kind: "Pod", APIVersion: "v1", Name: "debug", UID: "d", Pods: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d workloads, got %v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("workload %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
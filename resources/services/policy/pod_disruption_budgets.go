@@ -0,0 +1,474 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// coveredWorkload is a workload with pods selected by a pod disruption budget.
+type coveredWorkload struct {
+	Kind       string
+	APIVersion string
+	Name       string
+	UID        types.UID
+	Pods       int
+	ReadyPods  int
+}
+
+func PodDisruptionBudgets() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_policy_pod_disruption_budgets",
+		Description:  "PodDisruptionBudget is an object to define the max disruption that can be caused to a collection of pods",
+		Resolver:     fetchPolicyPodDisruptionBudgets,
+		Multiplex:    client.APIFilterContextMultiplex("/apis/policy/v1/poddisruptionbudgets"),
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"uid"}},
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "kind",
+				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.Kind"),
+			},
+			{
+				Name:        "api_version",
+				Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("TypeMeta.APIVersion"),
+			},
+			{
+				Name:        "name",
+				Description: "Name must be unique within a namespace",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Name"),
+			},
+			{
+				Name:        "generate_name",
+				Description: "GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.GenerateName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "Namespace defines the space within which each name must be unique",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.Namespace"),
+			},
+			{
+				Name:        "self_link",
+				Description: "SelfLink is a URL representing this object. Populated by the system. Read-only.  DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.SelfLink"),
+			},
+			{
+				Name:        "uid",
+				Description: "UID is the unique in time and space value for this object",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.UID"),
+			},
+			{
+				Name:        "resource_version",
+				Description: "An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ResourceVersion"),
+			},
+			{
+				Name:        "generation",
+				Description: "A sequence number representing a specific generation of the desired state. Populated by the system",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ObjectMeta.Generation"),
+			},
+			{
+				Name:          "deletion_grace_period_seconds",
+				Description:   "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("ObjectMeta.DeletionGracePeriodSeconds"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "labels",
+				Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Labels"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ObjectMeta.Annotations"),
+			},
+			{
+				Name:          "owner_references",
+				Description:   "List of objects depended by this object",
+				Type:          schema.TypeJSON,
+				Resolver:      resolvePolicyPodDisruptionBudgetsOwnerReferences,
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "finalizers",
+				Description:   "Must be empty before the object is deleted from the registry",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("ObjectMeta.Finalizers"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ObjectMeta.ClusterName"),
+			},
+			{
+				Name:        "managed_fields",
+				Description: "ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow",
+				Type:        schema.TypeJSON,
+				Resolver:    resolvePolicyPodDisruptionBudgetsManagedFields,
+			},
+			{
+				Name:     "min_available_type",
+				Type:     schema.TypeBigInt,
+				Resolver: schema.PathResolver("Spec.MinAvailable.Type"),
+			},
+			{
+				Name:     "min_available_int_val",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("Spec.MinAvailable.IntVal"),
+			},
+			{
+				Name:     "min_available_str_val",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Spec.MinAvailable.StrVal"),
+			},
+			{
+				Name:          "max_unavailable_type",
+				Type:          schema.TypeBigInt,
+				Resolver:      schema.PathResolver("Spec.MaxUnavailable.Type"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "max_unavailable_int_val",
+				Type:          schema.TypeInt,
+				Resolver:      schema.PathResolver("Spec.MaxUnavailable.IntVal"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:          "max_unavailable_str_val",
+				Type:          schema.TypeString,
+				Resolver:      schema.PathResolver("Spec.MaxUnavailable.StrVal"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "selector_match_labels",
+				Description: "matchLabels is a map of {key,value} pairs",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("Spec.Selector.MatchLabels"),
+			},
+			{
+				Name:        "status_observed_generation",
+				Description: "Most recent generation observed when updating this PDB status.",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("Status.ObservedGeneration"),
+			},
+			{
+				Name:          "status_disrupted_pods",
+				Description:   "DisruptedPods contains information about pods whose eviction was processed by the API server eviction subresource handler but has not yet been observed by the PodDisruptionBudget controller.",
+				Type:          schema.TypeJSON,
+				Resolver:      resolvePolicyPodDisruptionBudgetsStatusDisruptedPods,
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "status_disruptions_allowed",
+				Description: "Number of pod disruptions that are currently allowed.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.DisruptionsAllowed"),
+			},
+			{
+				Name:        "status_current_healthy",
+				Description: "current number of healthy pods",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.CurrentHealthy"),
+			},
+			{
+				Name:        "status_desired_healthy",
+				Description: "minimum desired number of healthy pods",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.DesiredHealthy"),
+			},
+			{
+				Name:        "status_expected_pods",
+				Description: "total number of pods counted by this disruption budget",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("Status.ExpectedPods"),
+			},
+			{
+				Name:        "blocks_disruptions",
+				Description: "True if the budget selects pods but currently allows no disruptions, so evictions (e.g. by node drains) of the selected pods are rejected.",
+				Type:        schema.TypeBool,
+				Resolver:    resolvePolicyPodDisruptionBudgetsBlocksDisruptions,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "k8s_policy_pod_disruption_budget_selector_match_expressions",
+				Description: "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+				Resolver:    fetchPolicyPodDisruptionBudgetSelectorMatchExpressions,
+				Columns: []schema.Column{
+					{
+						Name:        "pod_disruption_budget_cq_id",
+						Description: "Unique CloudQuery ID of k8s_policy_pod_disruption_budgets table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "key",
+						Description: "key is the label key that the selector applies to. +patchMergeKey=key +patchStrategy=merge",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "operator",
+						Description: "operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "values",
+						Description: "values is an array of string values",
+						Type:        schema.TypeStringArray,
+					},
+				},
+			},
+			{
+				Name:        "k8s_policy_pod_disruption_budget_status_conditions",
+				Description: "Condition contains details for one aspect of the current state of this API Resource.",
+				Resolver:    fetchPolicyPodDisruptionBudgetStatusConditions,
+				Columns: []schema.Column{
+					{
+						Name:        "pod_disruption_budget_cq_id",
+						Description: "Unique CloudQuery ID of k8s_policy_pod_disruption_budgets table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "type",
+						Description: "type of condition in CamelCase or in foo.example.com/CamelCase.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "status",
+						Description: "status of the condition, one of True, False, Unknown.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "observed_generation",
+						Description: "observedGeneration represents the .metadata.generation that the condition was set based upon.",
+						Type:        schema.TypeBigInt,
+					},
+					{
+						Name:        "last_transition_time",
+						Description: "lastTransitionTime is the last time the condition transitioned from one status to another.",
+						Type:        schema.TypeTimestamp,
+						Resolver:    client.TimeResolver("LastTransitionTime"),
+					},
+					{
+						Name:        "reason",
+						Description: "reason contains a programmatic identifier indicating the reason for the condition's last transition.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "message",
+						Description: "message is a human readable message indicating details about the transition.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:        "k8s_policy_pod_disruption_budget_covered_workloads",
+				Description: "Workloads with pods selected by the pod disruption budget. Pods are attributed to the top-level owner of their controller, e.g. the deployment of their replica set; pods without a controller are listed as themselves.",
+				Resolver:    fetchPolicyPodDisruptionBudgetCoveredWorkloads,
+				Columns: []schema.Column{
+					{
+						Name:        "pod_disruption_budget_cq_id",
+						Description: "Unique CloudQuery ID of k8s_policy_pod_disruption_budgets table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "kind",
+						Description: "Kind of the workload, e.g. Deployment, StatefulSet or Pod.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "api_version",
+						Description: "API version of the workload.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("APIVersion"),
+					},
+					{
+						Name:        "name",
+						Description: "Name of the workload.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "uid",
+						Description: "UID of the workload.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("UID"),
+					},
+					{
+						Name:        "pods",
+						Description: "Number of pods of the workload selected by the budget.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "ready_pods",
+						Description: "Number of pods of the workload selected by the budget that are ready.",
+						Type:        schema.TypeInt,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchPolicyPodDisruptionBudgets(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client).Services().PodDisruptionBudgets
+	opts := metav1.ListOptions{}
+	for {
+		result, err := cl.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.Items
+		if result.GetContinue() == "" {
+			return nil
+		}
+		opts.Continue = result.GetContinue()
+	}
+}
+func resolvePolicyPodDisruptionBudgetsOwnerReferences(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(policyv1.PodDisruptionBudget)
+	b, err := json.Marshal(p.OwnerReferences)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolvePolicyPodDisruptionBudgetsManagedFields(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(policyv1.PodDisruptionBudget)
+	b, err := json.Marshal(p.ManagedFields)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolvePolicyPodDisruptionBudgetsStatusDisruptedPods(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(policyv1.PodDisruptionBudget)
+	b, err := json.Marshal(p.Status.DisruptedPods)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func resolvePolicyPodDisruptionBudgetsBlocksDisruptions(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(policyv1.PodDisruptionBudget)
+	return diag.WrapError(resource.Set(c.Name, p.Status.ExpectedPods > 0 && p.Status.DisruptionsAllowed == 0))
+}
+func fetchPolicyPodDisruptionBudgetSelectorMatchExpressions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	pdb := parent.Item.(policyv1.PodDisruptionBudget)
+	if pdb.Spec.Selector == nil {
+		return nil
+	}
+	res <- pdb.Spec.Selector.MatchExpressions
+	return nil
+}
+func fetchPolicyPodDisruptionBudgetStatusConditions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	pdb := parent.Item.(policyv1.PodDisruptionBudget)
+	res <- pdb.Status.Conditions
+	return nil
+}
+func fetchPolicyPodDisruptionBudgetCoveredWorkloads(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	pdb := parent.Item.(policyv1.PodDisruptionBudget)
+	// in policy/v1 a null selector selects no pods
+	if pdb.Spec.Selector == nil {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	cl := meta.(*client.Client)
+	inNamespace := fields.OneTermEqualSelector("metadata.namespace", pdb.Namespace).String()
+
+	var pods []corev1.Pod
+	opts := metav1.ListOptions{FieldSelector: inNamespace, LabelSelector: selector.String()}
+	for {
+		result, err := cl.Services().Pods.List(ctx, opts)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		pods = append(pods, result.Items...)
+		if result.GetContinue() == "" {
+			break
+		}
+		opts.Continue = result.GetContinue()
+	}
+	if len(pods) == 0 {
+		return nil
+	}
+
+	// the owner index is built once per context, rather than listing the owners for each budget
+	owners, err := cl.OwnerIndex(ctx)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	res <- coveredWorkloads(pods, owners.Root)
+	return nil
+}
+
+// coveredWorkloads groups the pods by the top-level owner of their controller, found by root.
+func coveredWorkloads(pods []corev1.Pod, root func(refs []metav1.OwnerReference) (metav1.OwnerReference, bool)) []coveredWorkload {
+	var workloads []coveredWorkload
+	index := make(map[types.UID]int)
+	for i := range pods {
+		pod := &pods[i]
+		w := coveredWorkload{Kind: "Pod", APIVersion: "v1", Name: pod.Name, UID: pod.UID}
+		if ref := metav1.GetControllerOf(pod); ref != nil {
+			if owner, ok := root([]metav1.OwnerReference{*ref}); ok {
+				w = coveredWorkload{Kind: owner.Kind, APIVersion: owner.APIVersion, Name: owner.Name, UID: owner.UID}
+			}
+		}
+		j, ok := index[w.UID]
+		if !ok {
+			j = len(workloads)
+			index[w.UID] = j
+			workloads = append(workloads, w)
+		}
+		workloads[j].Pods++
+		if isPodReady(pod) {
+			workloads[j].ReadyPods++
+		}
+	}
+	return workloads
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+//go:build mock
+// +build mock
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func createPodDisruptionBudgets(t *testing.T, ctrl *gomock.Controller) client.Services {
+	pdbs := mocks.NewMockPodDisruptionBudgetsClient(ctrl)
+	pdbs.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&policyv1.PodDisruptionBudgetList{Items: []policyv1.PodDisruptionBudget{fakePodDisruptionBudget(t)}}, nil,
+	)
+
+	var deployment, rs, pod metav1.ObjectMeta
+	k8sTesting.FakeThroughPointers(t, &deployment, &rs, &pod)
+	isController := true
+	rs.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: deployment.Name, UID: deployment.UID, Controller: &isController}}
+	pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: rs.Name, UID: rs.UID, Controller: &isController}}
+
+	pods := mocks.NewMockPodsClient(ctrl)
+	pods.EXPECT().List(gomock.Any(), gomock.Any()).Return(
+		&corev1.PodList{Items: []corev1.Pod{{ObjectMeta: pod}}}, nil,
+	)
+	replicaSets := mocks.NewMockReplicaSetsClient(ctrl)
+	replicaSets.EXPECT().List(gomock.Any(), gomock.Any()).Return(
+		&appsv1.ReplicaSetList{Items: []appsv1.ReplicaSet{{ObjectMeta: rs}}}, nil,
+	)
+	return client.Services{
+		PodDisruptionBudgets: pdbs,
+		Pods:                 pods,
+		ReplicaSets:          replicaSets,
+	}
+}
+
+func fakePodDisruptionBudget(t *testing.T) policyv1.PodDisruptionBudget {
+	var pdb policyv1.PodDisruptionBudget
+	k8sTesting.FakeThroughPointers(t,
+		&pdb.TypeMeta,
+		&pdb.ObjectMeta,
+		&pdb.Spec.Selector,
+		&pdb.Status.ObservedGeneration,
+		&pdb.Status.DisruptionsAllowed,
+		&pdb.Status.CurrentHealthy,
+		&pdb.Status.DesiredHealthy,
+		&pdb.Status.ExpectedPods,
+		&pdb.Status.Conditions,
+	)
+	pdb.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	minAvailable := intstr.FromString("50%")
+	pdb.Spec.MinAvailable = &minAvailable
+	pdb.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}}}
+	return pdb
+}
+
+func TestPodDisruptionBudgets(t *testing.T) {
+	client.K8sMockTestHelper(t, PodDisruptionBudgets(), createPodDisruptionBudgets, client.TestOptions{})
+}
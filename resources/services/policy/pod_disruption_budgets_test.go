@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationPodDisruptionBudgets(t *testing.T) {
+	client.K8sTestHelper(t, PodDisruptionBudgets(), "./snapshots")
+}
@@ -0,0 +1,186 @@
+package rbac
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// effectivePermission is a single verb a subject may perform, expanded from the rules of a bound role.
+type effectivePermission struct {
+	SubjectKind      string
+	SubjectName      string
+	SubjectNamespace string
+	ScopeNamespace   string
+	APIGroup         string
+	Resource         string
+	ResourceName     string
+	NonResourceURL   string
+	Verb             string
+	ViaBindingKind   string
+	ViaBinding       string
+	ViaRoleKind      string
+	ViaRole          string
+}
+
+func EffectivePermissions() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_rbac_effective_permissions",
+		Description:  "Permissions granted to subjects by roles and cluster roles (including aggregated cluster roles) through role bindings and cluster role bindings, expanded to one row per verb.",
+		Resolver:     fetchRbacEffectivePermissions,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "subject_kind",
+				Description: "Kind of the subject the permission is granted to: User, Group or ServiceAccount.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "subject_name",
+				Description: "Name of the subject the permission is granted to.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "subject_namespace",
+				Description: "Namespace of the service account subject, empty for users and groups.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "scope_namespace",
+				Description: "Namespace the permission applies to, empty for cluster-wide permissions granted by cluster role bindings.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "api_group",
+				Description: "API group of the resource, empty for the core group.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("APIGroup"),
+			},
+			{
+				Name:        "resource",
+				Description: "Resource or \"resource/subresource\" the permission applies to. Wildcards are expanded into the resources served by the cluster when discovery is available.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "resource_name",
+				Description: "Name of the single object the permission is restricted to, empty if it applies to all objects.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "non_resource_url",
+				Description: "Non-resource URL the permission applies to, only granted through cluster role bindings, set instead of the resource fields.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("NonResourceURL"),
+			},
+			{
+				Name:        "verb",
+				Description: "Verb the subject may perform. Wildcard verbs are expanded into the verbs served for the resource when discovery is available.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_binding_kind",
+				Description: "Kind of the binding granting the permission: RoleBinding or ClusterRoleBinding.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_binding",
+				Description: "Name of the binding granting the permission.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_role_kind",
+				Description: "Kind of the role granting the permission: Role or ClusterRole.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_role",
+				Description: "Name of the role granting the permission.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchRbacEffectivePermissions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	a, err := fetchAuthorization(ctx, c.Services())
+	if err != nil {
+		return err
+	}
+	discovered, err := discoverResources(c.Services().Discovery)
+	if err != nil {
+		c.Logger().Warn("failed to discover API resources, wildcards are kept as is", "err", err)
+	}
+	for _, g := range a.grants() {
+		res <- expandGrant(g, discovered)
+	}
+	return nil
+}
+
+// expandGrant expands the rules of a grant into one permission per group, resource, resource name and verb.
+// Non-resource URLs are only granted cluster-wide, through a ClusterRoleBinding.
+func expandGrant(g grant, discovered map[string][]apiResource) []effectivePermission {
+	base := effectivePermission{
+		SubjectKind:      g.Subject.Kind,
+		SubjectName:      g.Subject.Name,
+		SubjectNamespace: g.Subject.Namespace,
+		ScopeNamespace:   g.ScopeNamespace,
+		ViaBindingKind:   g.BindingKind,
+		ViaBinding:       g.BindingName,
+		ViaRoleKind:      g.RoleKind,
+		ViaRole:          g.RoleName,
+	}
+	var permissions []effectivePermission
+	for _, rule := range g.Rules {
+		var urls []string
+		if g.BindingKind == "ClusterRoleBinding" {
+			urls = rule.NonResourceURLs
+		}
+		for _, url := range urls {
+			for _, verb := range rule.Verbs {
+				p := base
+				p.NonResourceURL = url
+				p.Verb = verb
+				permissions = append(permissions, p)
+			}
+		}
+		resourceNames := rule.ResourceNames
+		if len(resourceNames) == 0 {
+			resourceNames = []string{""}
+		}
+		groups, expanded := expandGroups(discovered, rule.APIGroups)
+		for _, ruleResource := range rule.Resources {
+			var resources []apiResource
+			for _, group := range groups {
+				resources = append(resources, expandResources(discovered, group, ruleResource, expanded)...)
+			}
+			if len(resources) == 0 && expanded && !strings.Contains(ruleResource, "*") {
+				// no discovered group serves the resource, RBAC still allows granting it in any group
+				resources = append(resources, apiResource{Group: rbacv1.APIGroupAll, Name: ruleResource})
+			}
+			for _, r := range resources {
+				for _, verb := range expandVerbs(rule.Verbs, r) {
+					for _, name := range resourceNames {
+						p := base
+						p.APIGroup = r.Group
+						p.Resource = r.Name
+						p.ResourceName = name
+						p.Verb = verb
+						permissions = append(permissions, p)
+					}
+				}
+			}
+		}
+	}
+	return permissions
+}
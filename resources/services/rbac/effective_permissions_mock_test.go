@@ -0,0 +1,64 @@
+//go:build mock
+// +build mock
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	"github.com/golang/mock/gomock"
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createRbacEffectivePermissions(t *testing.T, ctrl *gomock.Controller) client.Services {
+	role := *fakeRole(t)
+	role.Namespace = "default"
+	role.Rules = []v1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"*"}}}
+	clusterRole := v1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "view-secrets"},
+		Rules:      []v1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "list"}}},
+	}
+	roleBinding := *fakeRoleBinding(t)
+	roleBinding.Namespace = "default"
+	roleBinding.RoleRef = v1.RoleRef{APIGroup: v1.GroupName, Kind: "Role", Name: role.Name}
+	roleBinding.Subjects = []v1.Subject{{Kind: v1.ServiceAccountKind, Name: "builder"}}
+	clusterRoleBinding := v1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "view-secrets"},
+		RoleRef:    v1.RoleRef{APIGroup: v1.GroupName, Kind: "ClusterRole", Name: clusterRole.Name},
+		Subjects:   []v1.Subject{{Kind: v1.GroupKind, APIGroup: v1.GroupName, Name: "auditors"}},
+	}
+
+	roles := mocks.NewMockRolesClient(ctrl)
+	roles.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.RoleList{Items: []v1.Role{role}}, nil)
+	clusterRoles := mocks.NewMockClusterRolesClient(ctrl)
+	clusterRoles.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.ClusterRoleList{Items: []v1.ClusterRole{clusterRole}}, nil)
+	roleBindings := mocks.NewMockRoleBindingsClient(ctrl)
+	roleBindings.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.RoleBindingList{Items: []v1.RoleBinding{roleBinding}}, nil)
+	clusterRoleBindings := mocks.NewMockClusterRoleBindingsClient(ctrl)
+	clusterRoleBindings.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.ClusterRoleBindingList{Items: []v1.ClusterRoleBinding{clusterRoleBinding}}, nil)
+	discovery := mocks.NewMockDiscoveryClient(ctrl)
+	discovery.EXPECT().ServerGroupsAndResources().Return(nil, []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Verbs: []string{"get", "list", "create"}},
+				{Name: "pods/exec", Verbs: []string{"create", "get"}},
+				{Name: "secrets", Verbs: []string{"get", "list"}},
+			},
+		},
+	}, nil)
+	return client.Services{
+		ClusterRoleBindings: clusterRoleBindings,
+		ClusterRoles:        clusterRoles,
+		Discovery:           discovery,
+		RoleBindings:        roleBindings,
+		Roles:               roles,
+	}
+}
+
+func TestRbacEffectivePermissions(t *testing.T) {
+	client.K8sMockTestHelper(t, EffectivePermissions(), createRbacEffectivePermissions, client.TestOptions{})
+}
@@ -0,0 +1,345 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// escalationRisk is a privilege-escalation technique available to a subject through a single rule.
+type escalationRisk struct {
+	SubjectKind      string
+	SubjectName      string
+	SubjectNamespace string
+	ScopeNamespace   string
+	Technique        string
+	Message          string
+	ViaBindingKind   string
+	ViaBinding       string
+	ViaRoleKind      string
+	ViaRole          string
+	Scoped           bool
+	Rule             rbacv1.PolicyRule
+}
+
+// permissionCheck matches rules granting any of the verbs on the resource of the API group, or on any of its
+// subresources if AnySubresource is set.
+type permissionCheck struct {
+	Group          string
+	Resource       string
+	Verbs          []string
+	AnySubresource bool
+}
+
+type escalationTechnique struct {
+	ID      string
+	Message string
+	Checks  []permissionCheck
+}
+
+// techniquePodCreation is evaluated separately as it depends on the service accounts found privileged by other techniques.
+const techniquePodCreation = "create_pods_with_privileged_service_account"
+
+var escalationTechniques = []escalationTechnique{
+	{
+		ID:      "escalate_roles",
+		Message: "can grant permissions it doesn't hold by editing roles with the escalate verb",
+		Checks: []permissionCheck{
+			{Group: rbacv1.GroupName, Resource: "roles", Verbs: []string{"escalate"}},
+			{Group: rbacv1.GroupName, Resource: "clusterroles", Verbs: []string{"escalate"}},
+		},
+	},
+	{
+		ID:      "bind_roles",
+		Message: "can bind roles with permissions it doesn't hold using the bind verb",
+		Checks: []permissionCheck{
+			{Group: rbacv1.GroupName, Resource: "roles", Verbs: []string{"bind"}},
+			{Group: rbacv1.GroupName, Resource: "clusterroles", Verbs: []string{"bind"}},
+		},
+	},
+	{
+		ID:      "write_role_bindings",
+		Message: "can create or modify role bindings",
+		Checks: []permissionCheck{
+			{Group: rbacv1.GroupName, Resource: "rolebindings", Verbs: []string{"create", "update", "patch"}},
+			{Group: rbacv1.GroupName, Resource: "clusterrolebindings", Verbs: []string{"create", "update", "patch"}},
+		},
+	},
+	{
+		ID:      "impersonate",
+		Message: "can impersonate other users, groups or service accounts",
+		Checks: []permissionCheck{
+			{Group: "", Resource: "users", Verbs: []string{"impersonate"}},
+			{Group: "", Resource: "groups", Verbs: []string{"impersonate"}},
+			{Group: "", Resource: "serviceaccounts", Verbs: []string{"impersonate"}},
+			{Group: "authentication.k8s.io", Resource: "userextras", Verbs: []string{"impersonate"}, AnySubresource: true},
+			{Group: "authentication.k8s.io", Resource: "uids", Verbs: []string{"impersonate"}},
+		},
+	},
+	{
+		ID:      "read_secrets",
+		Message: "can read secrets, including service account tokens",
+		Checks: []permissionCheck{
+			{Group: "", Resource: "secrets", Verbs: []string{"get", "list", "watch"}},
+		},
+	},
+	{
+		ID:      "create_service_account_tokens",
+		Message: "can request tokens for service accounts",
+		Checks: []permissionCheck{
+			{Group: "", Resource: "serviceaccounts/token", Verbs: []string{"create"}},
+		},
+	},
+	{
+		ID:      "exec_into_pods",
+		Message: "can execute commands in or attach to running containers",
+		Checks: []permissionCheck{
+			{Group: "", Resource: "pods/exec", Verbs: []string{"create", "get"}},
+			{Group: "", Resource: "pods/attach", Verbs: []string{"create", "get"}},
+		},
+	},
+	{
+		ID:      "proxy_to_nodes",
+		Message: "can reach the kubelet API of nodes, which allows executing commands in any pod on the node",
+		Checks: []permissionCheck{
+			{Group: "", Resource: "nodes/proxy", Verbs: []string{"create", "get"}},
+		},
+	},
+	{
+		ID:      "approve_certificate_signing_requests",
+		Message: "can approve certificate signing requests, e.g. for client certificates of privileged users",
+		Checks: []permissionCheck{
+			{Group: "certificates.k8s.io", Resource: "certificatesigningrequests/approval", Verbs: []string{"update", "patch"}},
+		},
+	},
+}
+
+// podCreationChecks matches rules that allow running pods directly or through workload controllers.
+var podCreationChecks = []permissionCheck{
+	{Group: "", Resource: "pods", Verbs: []string{"create"}},
+	{Group: "", Resource: "replicationcontrollers", Verbs: []string{"create", "update", "patch"}},
+	{Group: "apps", Resource: "deployments", Verbs: []string{"create", "update", "patch"}},
+	{Group: "apps", Resource: "daemonsets", Verbs: []string{"create", "update", "patch"}},
+	{Group: "apps", Resource: "statefulsets", Verbs: []string{"create", "update", "patch"}},
+	{Group: "apps", Resource: "replicasets", Verbs: []string{"create", "update", "patch"}},
+	{Group: "batch", Resource: "jobs", Verbs: []string{"create", "update", "patch"}},
+	{Group: "batch", Resource: "cronjobs", Verbs: []string{"create", "update", "patch"}},
+}
+
+func EscalationRisks() *schema.Table {
+	return &schema.Table{
+		Name:         "k8s_rbac_escalation_risks",
+		Description:  "Subjects able to escalate their privileges, with the technique and the rule that enables it.",
+		Resolver:     fetchRbacEscalationRisks,
+		Multiplex:    client.ContextMultiplex,
+		DeleteFilter: client.DeleteContextFilter,
+		IgnoreError:  client.IgnoreForbiddenNotFound,
+		Columns: []schema.Column{
+			client.CommonContextField,
+			{
+				Name:        "subject_kind",
+				Description: "Kind of the subject: User, Group or ServiceAccount.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "subject_name",
+				Description: "Name of the subject.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "subject_namespace",
+				Description: "Namespace of the service account subject, empty for users and groups.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "scope_namespace",
+				Description: "Namespace the enabling rule applies to, empty for cluster-wide rules granted by cluster role bindings.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "technique",
+				Description: "Identifier of the escalation technique, e.g. bind_roles, read_secrets or create_pods_with_privileged_service_account.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "message",
+				Description: "Explanation of what the subject can do.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_binding_kind",
+				Description: "Kind of the binding granting the rule: RoleBinding or ClusterRoleBinding.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_binding",
+				Description: "Name of the binding granting the rule.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_role_kind",
+				Description: "Kind of the role containing the rule: Role or ClusterRole.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "via_role",
+				Description: "Name of the role containing the rule.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "rule_api_groups",
+				Description: "APIGroups of the rule enabling the technique.",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("Rule.APIGroups"),
+			},
+			{
+				Name:        "rule_resources",
+				Description: "Resources of the rule enabling the technique.",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("Rule.Resources"),
+			},
+			{
+				Name:        "scoped",
+				Description: "Whether the enabling rule is restricted to named objects by resourceNames, which limits the technique to these objects.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:          "rule_resource_names",
+				Description:   "ResourceNames the rule enabling the technique is restricted to.",
+				Type:          schema.TypeStringArray,
+				Resolver:      schema.PathResolver("Rule.ResourceNames"),
+				IgnoreInTests: true,
+			},
+			{
+				Name:        "rule_verbs",
+				Description: "Verbs of the rule enabling the technique.",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("Rule.Verbs"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchRbacEscalationRisks(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	a, err := fetchAuthorization(ctx, meta.(*client.Client).Services())
+	if err != nil {
+		return err
+	}
+	res <- findEscalationRisks(a.grants())
+	return nil
+}
+
+// findEscalationRisks evaluates every escalation technique against the grants.
+func findEscalationRisks(grants []grant) []escalationRisk {
+	var risks []escalationRisk
+	// namespace -> service accounts that can escalate in the namespace or cluster-wide
+	privileged := make(map[string]map[string]bool)
+	for _, g := range grants {
+		for _, rule := range g.Rules {
+			for _, t := range escalationTechniques {
+				if !ruleMatchesAny(rule, t.Checks) {
+					continue
+				}
+				risk := newEscalationRisk(g, rule, t.ID, t.Message)
+				risks = append(risks, risk)
+				// rules restricted to named objects don't make a service account privileged cluster-wide
+				if g.Subject.Kind == rbacv1.ServiceAccountKind && !risk.Scoped {
+					if privileged[g.Subject.Namespace] == nil {
+						privileged[g.Subject.Namespace] = make(map[string]bool)
+					}
+					privileged[g.Subject.Namespace][g.Subject.Name] = true
+				}
+			}
+		}
+	}
+
+	for _, g := range grants {
+		for _, rule := range g.Rules {
+			if !ruleMatchesAny(rule, podCreationChecks) {
+				continue
+			}
+			accounts := privilegedServiceAccounts(privileged, g.ScopeNamespace)
+			if len(accounts) == 0 {
+				continue
+			}
+			msg := fmt.Sprintf("can run pods as privileged service accounts: %s", strings.Join(accounts, ", "))
+			risks = append(risks, newEscalationRisk(g, rule, techniquePodCreation, msg))
+		}
+	}
+	return risks
+}
+
+// privilegedServiceAccounts returns the privileged service accounts ("namespace/name") pods can run as in the namespace,
+// or in any namespace if it is empty.
+func privilegedServiceAccounts(privileged map[string]map[string]bool, namespace string) []string {
+	var accounts []string
+	for ns, names := range privileged {
+		if namespace != "" && ns != namespace {
+			continue
+		}
+		for name := range names {
+			accounts = append(accounts, ns+"/"+name)
+		}
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+func ruleMatchesAny(rule rbacv1.PolicyRule, checks []permissionCheck) bool {
+	for _, c := range checks {
+		for _, resource := range c.resources(rule) {
+			for _, verb := range c.Verbs {
+				if ruleAllows(rule, c.Group, resource, verb) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// resources returns the resources the check matches against the rule. Checks on any subresource match the
+// subresources of the resource the rule lists, e.g. userextras/scopes for impersonating the scopes extra.
+func (c permissionCheck) resources(rule rbacv1.PolicyRule) []string {
+	if !c.AnySubresource {
+		return []string{c.Resource}
+	}
+	var resources []string
+	for _, r := range rule.Resources {
+		switch {
+		case strings.HasPrefix(r, c.Resource+"/"):
+			resources = append(resources, r)
+		case r == rbacv1.ResourceAll:
+			resources = append(resources, c.Resource+"/*")
+		case strings.HasPrefix(r, "*/"):
+			resources = append(resources, c.Resource+r[1:])
+		}
+	}
+	return resources
+}
+
+func newEscalationRisk(g grant, rule rbacv1.PolicyRule, technique, message string) escalationRisk {
+	return escalationRisk{
+		SubjectKind:      g.Subject.Kind,
+		SubjectName:      g.Subject.Name,
+		SubjectNamespace: g.Subject.Namespace,
+		ScopeNamespace:   g.ScopeNamespace,
+		Technique:        technique,
+		Message:          message,
+		ViaBindingKind:   g.BindingKind,
+		ViaBinding:       g.BindingName,
+		ViaRoleKind:      g.RoleKind,
+		ViaRole:          g.RoleName,
+		Scoped:           len(rule.ResourceNames) > 0,
+		Rule:             rule,
+	}
+}
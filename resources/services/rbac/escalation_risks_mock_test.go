@@ -0,0 +1,43 @@
+//go:build mock
+// +build mock
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	"github.com/golang/mock/gomock"
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createRbacEscalationRisks(t *testing.T, ctrl *gomock.Controller) client.Services {
+	role := *fakeRole(t)
+	role.Namespace = "default"
+	role.Rules = []v1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"token"}, Verbs: []string{"get"}}}
+	roleBinding := *fakeRoleBinding(t)
+	roleBinding.Namespace = "default"
+	roleBinding.RoleRef = v1.RoleRef{APIGroup: v1.GroupName, Kind: "Role", Name: role.Name}
+	roleBinding.Subjects = []v1.Subject{{Kind: v1.ServiceAccountKind, Name: "builder"}}
+
+	roles := mocks.NewMockRolesClient(ctrl)
+	roles.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.RoleList{Items: []v1.Role{role}}, nil)
+	clusterRoles := mocks.NewMockClusterRolesClient(ctrl)
+	clusterRoles.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.ClusterRoleList{}, nil)
+	roleBindings := mocks.NewMockRoleBindingsClient(ctrl)
+	roleBindings.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.RoleBindingList{Items: []v1.RoleBinding{roleBinding}}, nil)
+	clusterRoleBindings := mocks.NewMockClusterRoleBindingsClient(ctrl)
+	clusterRoleBindings.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.ClusterRoleBindingList{}, nil)
+	return client.Services{
+		ClusterRoleBindings: clusterRoleBindings,
+		ClusterRoles:        clusterRoles,
+		RoleBindings:        roleBindings,
+		Roles:               roles,
+	}
+}
+
+func TestRbacEscalationRisks(t *testing.T) {
+	client.K8sMockTestHelper(t, EscalationRisks(), createRbacEscalationRisks, client.TestOptions{})
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationRbacEscalationRisks(t *testing.T) {
+	client.K8sTestHelper(t, EscalationRisks(), "./snapshots")
+}
@@ -0,0 +1,334 @@
+package rbac
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// grant is a single role (or cluster role) granted to a single subject through a binding.
+type grant struct {
+	Subject rbacv1.Subject
+	// ScopeNamespace is the namespace the grant applies to, empty for cluster-wide grants.
+	ScopeNamespace string
+
+	BindingKind string
+	BindingName string
+	RoleKind    string
+	RoleName    string
+	Rules       []rbacv1.PolicyRule
+}
+
+// authorization holds all RBAC objects of a single context.
+type authorization struct {
+	Roles               []rbacv1.Role
+	ClusterRoles        []rbacv1.ClusterRole
+	RoleBindings        []rbacv1.RoleBinding
+	ClusterRoleBindings []rbacv1.ClusterRoleBinding
+}
+
+// apiResource is a resource (or subresource) served by the cluster.
+type apiResource struct {
+	Group string
+	Name  string
+	Verbs []string
+}
+
+// verbsNotInDiscovery lists the special verbs that RBAC understands for a resource but discovery doesn't advertise.
+var verbsNotInDiscovery = map[schema.GroupResource][]string{
+	{Group: "rbac.authorization.k8s.io", Resource: "roles"}:        {"bind", "escalate"},
+	{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}: {"bind", "escalate"},
+	{Group: "", Resource: "users"}:                                 {"impersonate"},
+	{Group: "", Resource: "groups"}:                                {"impersonate"},
+	{Group: "", Resource: "serviceaccounts"}:                       {"impersonate"},
+	{Group: "authentication.k8s.io", Resource: "userextras"}:       {"impersonate"},
+	{Group: "authentication.k8s.io", Resource: "uids"}:             {"impersonate"},
+	{Group: "certificates.k8s.io", Resource: "signers"}:            {"approve", "sign"},
+	{Group: "policy", Resource: "podsecuritypolicies"}:             {"use"},
+}
+
+func fetchAuthorization(ctx context.Context, svc client.Services) (*authorization, error) {
+	var (
+		a   authorization
+		err error
+	)
+	if a.Roles, err = client.ListAll(ctx, svc.Roles.List, func(l *rbacv1.RoleList) []rbacv1.Role { return l.Items }); err != nil {
+		return nil, err
+	}
+	if a.ClusterRoles, err = client.ListAll(ctx, svc.ClusterRoles.List, func(l *rbacv1.ClusterRoleList) []rbacv1.ClusterRole { return l.Items }); err != nil {
+		return nil, err
+	}
+	if a.RoleBindings, err = client.ListAll(ctx, svc.RoleBindings.List, func(l *rbacv1.RoleBindingList) []rbacv1.RoleBinding { return l.Items }); err != nil {
+		return nil, err
+	}
+	if a.ClusterRoleBindings, err = client.ListAll(ctx, svc.ClusterRoleBindings.List, func(l *rbacv1.ClusterRoleBindingList) []rbacv1.ClusterRoleBinding { return l.Items }); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// discoverResources returns the resources served by the cluster grouped by API group.
+// Partial results are returned if some of the groups couldn't be discovered.
+func discoverResources(cl client.DiscoveryClient) (map[string][]apiResource, error) {
+	_, lists, err := cl.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	seen := make(map[schema.GroupResource]int)
+	resources := make(map[string][]apiResource)
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		gv, perr := schema.ParseGroupVersion(list.GroupVersion)
+		if perr != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			gr := schema.GroupResource{Group: gv.Group, Resource: r.Name}
+			if i, ok := seen[gr]; ok {
+				// the same resource is usually served in several versions, merge the verbs
+				resources[gv.Group][i].Verbs = mergeVerbs(resources[gv.Group][i].Verbs, r.Verbs)
+				continue
+			}
+			seen[gr] = len(resources[gv.Group])
+			resources[gv.Group] = append(resources[gv.Group], apiResource{
+				Group: gv.Group,
+				Name:  r.Name,
+				Verbs: mergeVerbs(r.Verbs, verbsNotInDiscovery[gr]),
+			})
+		}
+	}
+	return resources, err
+}
+
+func mergeVerbs(verbs []string, extra []string) []string {
+	result := append([]string{}, verbs...)
+	for _, v := range extra {
+		if !containsString(result, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// grants resolves every binding into the roles and subjects it refers to.
+// Bindings referring to missing roles are skipped.
+func (a *authorization) grants() []grant {
+	roles := make(map[string]rbacv1.Role, len(a.Roles))
+	for _, r := range a.Roles {
+		roles[r.Namespace+"/"+r.Name] = r
+	}
+	clusterRoleRules := a.clusterRoleRules()
+
+	var grants []grant
+	for _, b := range a.RoleBindings {
+		var rules []rbacv1.PolicyRule
+		switch b.RoleRef.Kind {
+		case "Role":
+			r, ok := roles[b.Namespace+"/"+b.RoleRef.Name]
+			if !ok {
+				continue
+			}
+			rules = r.Rules
+		case "ClusterRole":
+			r, ok := clusterRoleRules[b.RoleRef.Name]
+			if !ok {
+				continue
+			}
+			rules = r
+		default:
+			continue
+		}
+		for _, s := range b.Subjects {
+			grants = append(grants, grant{
+				Subject:        normalizeSubject(s, b.Namespace),
+				ScopeNamespace: b.Namespace,
+				BindingKind:    "RoleBinding",
+				BindingName:    b.Name,
+				RoleKind:       b.RoleRef.Kind,
+				RoleName:       b.RoleRef.Name,
+				Rules:          rules,
+			})
+		}
+	}
+	for _, b := range a.ClusterRoleBindings {
+		rules, ok := clusterRoleRules[b.RoleRef.Name]
+		if !ok || b.RoleRef.Kind != "ClusterRole" {
+			continue
+		}
+		for _, s := range b.Subjects {
+			grants = append(grants, grant{
+				Subject:     normalizeSubject(s, ""),
+				BindingKind: "ClusterRoleBinding",
+				BindingName: b.Name,
+				RoleKind:    b.RoleRef.Kind,
+				RoleName:    b.RoleRef.Name,
+				Rules:       rules,
+			})
+		}
+	}
+	return grants
+}
+
+// clusterRoleRules returns the rules of every cluster role, including the rules of the cluster roles
+// selected by aggregation rules.
+func (a *authorization) clusterRoleRules() map[string][]rbacv1.PolicyRule {
+	byName := make(map[string]rbacv1.ClusterRole, len(a.ClusterRoles))
+	for _, r := range a.ClusterRoles {
+		byName[r.Name] = r
+	}
+	result := make(map[string][]rbacv1.PolicyRule, len(a.ClusterRoles))
+	var resolve func(name string, visited map[string]bool) []rbacv1.PolicyRule
+	resolve = func(name string, visited map[string]bool) []rbacv1.PolicyRule {
+		role := byName[name]
+		visited[name] = true
+		rules := append([]rbacv1.PolicyRule{}, role.Rules...)
+		if role.AggregationRule == nil {
+			return rules
+		}
+		for _, sel := range role.AggregationRule.ClusterRoleSelectors {
+			selector, err := metav1.LabelSelectorAsSelector(&sel)
+			if err != nil {
+				continue
+			}
+			for _, other := range a.ClusterRoles {
+				if visited[other.Name] || !selector.Matches(labels.Set(other.Labels)) {
+					continue
+				}
+				for _, r := range resolve(other.Name, visited) {
+					if !containsRule(rules, r) {
+						rules = append(rules, r)
+					}
+				}
+			}
+		}
+		return rules
+	}
+	for _, r := range a.ClusterRoles {
+		result[r.Name] = resolve(r.Name, make(map[string]bool))
+	}
+	return result
+}
+
+// normalizeSubject fills the namespace of service account subjects that rely on the binding namespace.
+func normalizeSubject(s rbacv1.Subject, bindingNamespace string) rbacv1.Subject {
+	if s.Kind == rbacv1.ServiceAccountKind && s.Namespace == "" {
+		s.Namespace = bindingNamespace
+	}
+	return s
+}
+
+// ruleAllows reports whether the rule grants the verb on the resource (or "resource/subresource")
+// of the API group, following the RBAC authorizer matching rules.
+func ruleAllows(rule rbacv1.PolicyRule, group, resource, verb string) bool {
+	if !matchesAny(rule.Verbs, verb) || !matchesAny(rule.APIGroups, group) {
+		return false
+	}
+	for _, r := range rule.Resources {
+		if r == rbacv1.ResourceAll || r == resource {
+			return true
+		}
+		if i := strings.Index(resource, "/"); i >= 0 && r == "*"+resource[i:] {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(values []string, v string) bool {
+	for _, value := range values {
+		if value == "*" || value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// expandResources returns the served resources matching a rule resource in the API group. If the group comes from
+// the rule itself and either wasn't discovered or doesn't serve the resource, the rule resource is returned as is.
+// Groups expanded from a wildcard only match the resources they serve.
+func expandResources(discovered map[string][]apiResource, group, ruleResource string, expanded bool) []apiResource {
+	served, ok := discovered[group]
+	if !ok {
+		return []apiResource{{Group: group, Name: ruleResource}}
+	}
+	var result []apiResource
+	for _, r := range served {
+		switch {
+		case ruleResource == rbacv1.ResourceAll,
+			r.Name == ruleResource,
+			strings.HasPrefix(ruleResource, "*/") && strings.HasSuffix(r.Name, ruleResource[1:]):
+			result = append(result, r)
+		}
+	}
+	if len(result) == 0 && !expanded && !strings.Contains(ruleResource, "*") {
+		// the resource might not be served (yet), RBAC still allows granting it
+		result = append(result, apiResource{Group: group, Name: ruleResource})
+	}
+	return result
+}
+
+// expandGroups returns the API groups matching the rule API groups, and whether they were expanded from a
+// wildcard.
+func expandGroups(discovered map[string][]apiResource, ruleGroups []string) ([]string, bool) {
+	if !containsString(ruleGroups, rbacv1.APIGroupAll) || len(discovered) == 0 {
+		return ruleGroups, false
+	}
+	groups := make([]string, 0, len(discovered))
+	for g := range discovered {
+		groups = append(groups, g)
+	}
+	sort.Strings(groups)
+	return groups, true
+}
+
+// expandVerbs returns the verbs the rule grants on the resource. Wildcard verbs are expanded into the
+// verbs served for the resource when they are known.
+func expandVerbs(ruleVerbs []string, r apiResource) []string {
+	if !containsString(ruleVerbs, rbacv1.VerbAll) || len(r.Verbs) == 0 {
+		return ruleVerbs
+	}
+	return r.Verbs
+}
+
+func containsString(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRule(rules []rbacv1.PolicyRule, rule rbacv1.PolicyRule) bool {
+	for _, r := range rules {
+		if equalStrings(r.Verbs, rule.Verbs) &&
+			equalStrings(r.APIGroups, rule.APIGroups) &&
+			equalStrings(r.Resources, rule.Resources) &&
+			equalStrings(r.ResourceNames, rule.ResourceNames) &&
+			equalStrings(r.NonResourceURLs, rule.NonResourceURLs) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
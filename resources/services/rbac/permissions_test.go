@@ -0,0 +1,239 @@
+package rbac
+
+import (
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRuleAllows(t *testing.T) {
+	cases := []struct {
+		name     string
+		rule     rbacv1.PolicyRule
+		group    string
+		resource string
+		verb     string
+		want     bool
+	}{
+		{
+			name:     "exact",
+			rule:     rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}},
+			resource: "secrets",
+			verb:     "get",
+			want:     true,
+		},
+		{
+			name:     "other verb",
+			rule:     rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}},
+			resource: "secrets",
+			verb:     "list",
+		},
+		{
+			name:     "wildcards",
+			rule:     rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+			group:    "rbac.authorization.k8s.io",
+			resource: "clusterroles",
+			verb:     "escalate",
+			want:     true,
+		},
+		{
+			name:     "subresource wildcard",
+			rule:     rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"*/exec"}, Verbs: []string{"create"}},
+			resource: "pods/exec",
+			verb:     "create",
+			want:     true,
+		},
+		{
+			name:     "resource doesn't grant subresource",
+			rule:     rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"create"}},
+			resource: "pods/exec",
+			verb:     "create",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ruleAllows(tc.rule, tc.group, tc.resource, tc.verb); got != tc.want {
+				t.Fatalf("ruleAllows() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestClusterRoleAggregation(t *testing.T) {
+	a := authorization{
+		ClusterRoles: []rbacv1.ClusterRole{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "monitoring"},
+				AggregationRule: &rbacv1.AggregationRule{
+					ClusterRoleSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"aggregate-to-monitoring": "true"}}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "pods-reader", Labels: map[string]string{"aggregate-to-monitoring": "true"}},
+				Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "unrelated"},
+				Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}}},
+			},
+		},
+	}
+	rules := a.clusterRoleRules()["monitoring"]
+	if len(rules) != 1 || rules[0].Resources[0] != "pods" {
+		t.Fatalf("unexpected aggregated rules: %v", rules)
+	}
+}
+
+func TestExpandGrant(t *testing.T) {
+	discovered := map[string][]apiResource{
+		"": {
+			{Name: "pods", Verbs: []string{"get", "list"}},
+			{Name: "pods/exec", Verbs: []string{"create"}},
+		},
+		"apps": {
+			{Group: "apps", Name: "deployments", Verbs: []string{"get"}},
+		},
+	}
+	g := grant{
+		Subject:     rbacv1.Subject{Kind: rbacv1.UserKind, Name: "jane"},
+		BindingKind: "ClusterRoleBinding",
+		BindingName: "jane",
+		RoleKind:    "ClusterRole",
+		RoleName:    "pods-admin",
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"*"}, Verbs: []string{"*"}},
+			{NonResourceURLs: []string{"/healthz"}, Verbs: []string{"get"}},
+		},
+	}
+	got := expandGrant(g, discovered)
+	want := map[string]bool{"pods get": true, "pods list": true, "pods/exec create": true, "/healthz get": true}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d permissions, got %d: %v", len(want), len(got), got)
+	}
+	for _, p := range got {
+		key := p.Resource + p.NonResourceURL + " " + p.Verb
+		if !want[key] {
+			t.Errorf("unexpected permission %q", key)
+		}
+	}
+
+	// without discovery wildcards are kept as is
+	if got := expandGrant(g, nil); len(got) != 2 || got[0].Resource != "*" || got[0].Verb != "*" {
+		t.Fatalf("unexpected permissions without discovery: %v", got)
+	}
+
+	// a cluster role bound in a namespace grants no non-resource URLs
+	g.BindingKind = "RoleBinding"
+	g.ScopeNamespace = "default"
+	for _, p := range expandGrant(g, discovered) {
+		if p.NonResourceURL != "" {
+			t.Errorf("unexpected non-resource permission %q through a role binding", p.NonResourceURL+" "+p.Verb)
+		}
+	}
+}
+
+func TestExpandGrantWildcardGroups(t *testing.T) {
+	discovered := map[string][]apiResource{
+		"":               {{Name: "pods", Verbs: []string{"get"}}},
+		"apps":           {{Group: "apps", Name: "deployments", Verbs: []string{"get"}}},
+		"batch":          {{Group: "batch", Name: "jobs", Verbs: []string{"get"}}},
+		"storage.k8s.io": {{Group: "storage.k8s.io", Name: "storageclasses", Verbs: []string{"get"}}},
+	}
+	g := grant{
+		Subject: rbacv1.Subject{Kind: rbacv1.UserKind, Name: "jane"},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{"*"}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+			{APIGroups: []string{"*"}, Resources: []string{"widgets"}, Verbs: []string{"get"}},
+		},
+	}
+	got := expandGrant(g, discovered)
+	want := map[string]bool{"/pods": true, "*/widgets": true}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d permissions, got %d: %v", len(want), len(got), got)
+	}
+	for _, p := range got {
+		if key := p.APIGroup + "/" + p.Resource; !want[key] {
+			t.Errorf("unexpected permission on %q", key)
+		}
+	}
+}
+
+func TestFindEscalationRisks(t *testing.T) {
+	grants := []grant{
+		{
+			Subject:        rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "ci", Namespace: "build"},
+			ScopeNamespace: "build",
+			BindingKind:    "RoleBinding",
+			RoleKind:       "ClusterRole",
+			RoleName:       "edit-bindings",
+			Rules:          []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"rolebindings"}, Verbs: []string{"*"}}},
+		},
+		{
+			Subject:        rbacv1.Subject{Kind: rbacv1.UserKind, Name: "dev"},
+			ScopeNamespace: "build",
+			BindingKind:    "RoleBinding",
+			RoleKind:       "Role",
+			RoleName:       "deployer",
+			Rules:          []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"create"}}},
+		},
+		{
+			Subject:        rbacv1.Subject{Kind: rbacv1.UserKind, Name: "other"},
+			ScopeNamespace: "web",
+			BindingKind:    "RoleBinding",
+			RoleKind:       "Role",
+			RoleName:       "deployer",
+			Rules:          []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"create", "get"}}},
+		},
+	}
+	risks := findEscalationRisks(grants)
+	got := make(map[string]string)
+	for _, r := range risks {
+		got[r.SubjectName] = r.Technique
+	}
+	want := map[string]string{"ci": "write_role_bindings", "dev": techniquePodCreation}
+	if len(risks) != len(want) {
+		t.Fatalf("expected %d risks, got %v", len(want), risks)
+	}
+	for subject, technique := range want {
+		if got[subject] != technique {
+			t.Errorf("expected technique %q for %q, got %q", technique, subject, got[subject])
+		}
+	}
+}
+
+func TestFindEscalationRisksScoped(t *testing.T) {
+	grants := []grant{
+		{
+			Subject:        rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "app", Namespace: "web"},
+			ScopeNamespace: "web",
+			RoleKind:       "Role",
+			RoleName:       "app-secret",
+			Rules:          []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"app"}, Verbs: []string{"get"}}},
+		},
+		{
+			Subject:        rbacv1.Subject{Kind: rbacv1.UserKind, Name: "dev"},
+			ScopeNamespace: "web",
+			RoleKind:       "Role",
+			RoleName:       "deployer",
+			Rules:          []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"create"}}},
+		},
+		{
+			Subject:  rbacv1.Subject{Kind: rbacv1.UserKind, Name: "proxy"},
+			RoleKind: "ClusterRole",
+			RoleName: "impersonate-scopes",
+			Rules:    []rbacv1.PolicyRule{{APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"userextras/scopes"}, Verbs: []string{"impersonate"}}},
+		},
+	}
+	risks := findEscalationRisks(grants)
+	if len(risks) != 2 {
+		t.Fatalf("expected 2 risks, got %+v", risks)
+	}
+	// the service account can only read its own secret, so pods running as it aren't a risk
+	if r := risks[0]; r.SubjectName != "app" || r.Technique != "read_secrets" || !r.Scoped {
+		t.Errorf("expected a scoped read_secrets risk, got %+v", r)
+	}
+	if r := risks[1]; r.SubjectName != "proxy" || r.Technique != "impersonate" || r.Scoped {
+		t.Errorf("expected an impersonate risk, got %+v", r)
+	}
+}
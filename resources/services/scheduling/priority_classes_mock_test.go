@@ -0,0 +1,45 @@
+//go:build mock
+// +build mock
+
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+	"github.com/cloudquery/cq-provider-k8s/client/mocks"
+	k8sTesting "github.com/cloudquery/cq-provider-k8s/resources/services/testing"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createPriorityClasses(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockPriorityClassesClient(ctrl)
+	m.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(
+		&schedulingv1.PriorityClassList{Items: []schedulingv1.PriorityClass{fakePriorityClass(t)}}, nil,
+	)
+	return client.Services{
+		PriorityClasses: m,
+	}
+}
+
+func fakePriorityClass(t *testing.T) schedulingv1.PriorityClass {
+	var pc schedulingv1.PriorityClass
+	k8sTesting.FakeThroughPointers(t,
+		&pc.TypeMeta,
+		&pc.ObjectMeta,
+		&pc.Value,
+		&pc.GlobalDefault,
+		&pc.Description,
+	)
+	pc.ManagedFields = []metav1.ManagedFieldsEntry{k8sTesting.FakeManagedFields(t)}
+	policy := corev1.PreemptLowerPriority
+	pc.PreemptionPolicy = &policy
+	return pc
+}
+
+func TestPriorityClasses(t *testing.T) {
+	client.K8sMockTestHelper(t, PriorityClasses(), createPriorityClasses, client.TestOptions{})
+}
@@ -0,0 +1,14 @@
+//go:build integration
+// +build integration
+
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-k8s/client"
+)
+
+func TestIntegrationPriorityClasses(t *testing.T) {
+	client.K8sTestHelper(t, PriorityClasses(), "./snapshots")
+}
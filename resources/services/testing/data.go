@@ -100,7 +100,12 @@ func FakeNode(t *testing.T) corev1.Node {
 }
 
 func FakeResourceList(t *testing.T) *corev1.ResourceList {
-	rl := make(corev1.ResourceList)
+	rl := corev1.ResourceList{
+		corev1.ResourceCPU:              apiresource.MustParse("500m"),
+		corev1.ResourceMemory:           apiresource.MustParse("1Gi"),
+		corev1.ResourceEphemeralStorage: apiresource.MustParse("2Gi"),
+		"nvidia.com/gpu":                apiresource.MustParse("1"),
+	}
 	rl[corev1.ResourceName(faker.UUIDHyphenated())] = *apiresource.NewQuantity(faker.UnixTime(), apiresource.BinarySI)
 	return &rl
 }
@@ -224,9 +229,22 @@ func FakePod(t *testing.T) corev1.Pod {
 	pod.Status.HostIP = "192.168.1.2"
 	pod.Status.PodIP = "192.168.1.1"
 	pod.Status.PodIPs = []corev1.PodIP{{IP: "192.168.1.1"}}
+	pod.Status.InitContainerStatuses = fakeContainerStatuses(t, pod.Spec.InitContainers[0].Name, pod.Spec.InitContainers[0].Image)
+	pod.Status.ContainerStatuses = fakeContainerStatuses(t, pod.Spec.Containers[0].Name, pod.Spec.Containers[0].Image)
+	pod.Status.EphemeralContainerStatuses = fakeContainerStatuses(t, pod.Spec.EphemeralContainers[0].Name, pod.Spec.EphemeralContainers[0].Image)
 	return pod
 }
 
+// fakeContainerStatuses returns the status of a container with a resolved image ID.
+func fakeContainerStatuses(t *testing.T, name, image string) []corev1.ContainerStatus {
+	var status corev1.ContainerStatus
+	FakeThroughPointers(t, &status)
+	status.Name = name
+	status.Image = image
+	status.ImageID = "docker-pullable://" + image + "@sha256:4ed64c2e0857ad21c38b98345ebb5edb01791a0a10b0e9e3d9ddde185cdbd31a"
+	return []corev1.ContainerStatus{status}
+}
+
 func FakePodSpec(t *testing.T) corev1.PodSpec {
 	var podSpec corev1.PodSpec
 	if err := faker.FakeDataSkipFields(&podSpec, []string{
@@ -272,13 +290,14 @@ func FakePersistentVolumeClaim(t *testing.T) *corev1.PersistentVolumeClaim {
 	if err := faker.FakeDataSkipFields(&claim, []string{"Spec", "Status"}); err != nil {
 		t.Fatal(err)
 	}
-	if err := faker.FakeDataSkipFields(&claim.Status, []string{"Capacity", "Phase"}); err != nil {
+	if err := faker.FakeDataSkipFields(&claim.Status, []string{"Capacity", "Phase", "AllocatedResources"}); err != nil {
 		t.Fatal(err)
 	}
 
 	claim.ManagedFields = []metav1.ManagedFieldsEntry{FakeManagedFields(t)}
 	claim.Status.Phase = "test"
 	claim.Status.Capacity = *FakeResourceList(t)
+	claim.Status.AllocatedResources = *FakeResourceList(t)
 	if err := faker.FakeDataSkipFields(&claim.Spec, []string{"Resources"}); err != nil {
 		t.Fatal(err)
 	}
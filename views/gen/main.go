@@ -0,0 +1,16 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/cloudquery/cq-provider-k8s/resources/provider"
+	"github.com/cloudquery/cq-provider-k8s/views"
+)
+
+func main() {
+	view := views.GenerateWorkloadContainersView(provider.Provider().ResourceMap)
+	if err := os.WriteFile("workload_containers.sql", []byte(view), 0644); err != nil {
+		log.Fatalf("Failed to generate workload containers view: %s", err)
+	}
+}
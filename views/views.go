@@ -2,5 +2,10 @@ package views
 
 import _ "embed"
 
+//go:generate go run ./gen
+
 //go:embed resource.sql
 var ResourceView string
+
+//go:embed workload_containers.sql
+var WorkloadContainersView string
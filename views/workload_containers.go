@@ -0,0 +1,131 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// workloadContainerColumns are the container columns exposed by the k8s_workload_containers view next to the
+// workload and container identifiers.
+var workloadContainerColumns = []string{
+	"image",
+	"privileged",
+	"allow_privilege_escalation",
+	"run_as_non_root",
+	"run_as_user",
+	"run_as_group",
+	"read_only_root_filesystem",
+	"capabilities_add",
+	"capabilities_drop",
+	"seccomp_profile_type",
+	"apparmor_profile",
+}
+
+// GenerateWorkloadContainersView returns the SQL creating the k8s_workload_containers view, which unions the
+// container tables of pods and of the pod templates of workloads found in tables.
+func GenerateWorkloadContainersView(tables map[string]*schema.Table) string {
+	type containerTable struct {
+		workload  *schema.Table
+		container *schema.Table
+	}
+	var containerTables []containerTable
+	types := make(map[string]string)
+	for _, t := range tables {
+		if !hasColumns(t, "context", "uid", "namespace", "name") {
+			continue
+		}
+		for _, r := range t.Relations {
+			if !strings.HasSuffix(r.Name, "_containers") || !hasColumns(r, "name", "image") {
+				continue
+			}
+			containerTables = append(containerTables, containerTable{workload: t, container: r})
+			for _, c := range r.Columns {
+				if _, ok := types[c.Name]; !ok {
+					types[c.Name] = schema.PostgresDialect{}.DBTypeFromType(c.Type)
+				}
+			}
+		}
+	}
+	sort.Slice(containerTables, func(i, j int) bool {
+		return containerTables[i].container.Name < containerTables[j].container.Name
+	})
+
+	selects := make([]string, 0, len(containerTables))
+	for _, ct := range containerTables {
+		columns := []string{
+			"w.context",
+			fmt.Sprintf("'%s' AS workload_kind", workloadKind(ct.workload.Name)),
+			"w.uid AS workload_uid",
+			"w.namespace",
+			"w.name AS workload_name",
+			"c.name AS container_name",
+			fmt.Sprintf("'%s' AS container_type", containerType(ct.container.Name)),
+		}
+		for _, name := range workloadContainerColumns {
+			switch {
+			case hasColumns(ct.container, name):
+				columns = append(columns, "c."+name)
+			case types[name] != "":
+				columns = append(columns, fmt.Sprintf("NULL::%s AS %s", types[name], name))
+			}
+		}
+		selects = append(selects, fmt.Sprintf("SELECT %s\nFROM %s c\n         JOIN %s w ON w.cq_id = c.%s",
+			strings.Join(columns, ", "), ct.container.Name, ct.workload.Name, parentIDColumn(ct.container)))
+	}
+	return "-- Code generated by views/gen. DO NOT EDIT.\n" +
+		"DROP VIEW IF EXISTS k8s_workload_containers;\n" +
+		"CREATE VIEW k8s_workload_containers AS\n" +
+		strings.Join(selects, "\nUNION ALL\n") + ";\n"
+}
+
+// workloadKind returns the kind of the objects stored in a table, e.g. StatefulSet for k8s_apps_stateful_sets.
+func workloadKind(table string) string {
+	parts := strings.SplitN(table, "_", 3)
+	words := strings.Split(strings.TrimSuffix(parts[len(parts)-1], "s"), "_")
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, "")
+}
+
+// containerType returns the type of the containers stored in a table: init, ephemeral or container for regular
+// containers, e.g. init for k8s_core_pod_init_containers.
+func containerType(table string) string {
+	switch {
+	case strings.HasSuffix(table, "_init_containers"):
+		return "init"
+	case strings.HasSuffix(table, "_ephemeral_containers"):
+		return "ephemeral"
+	default:
+		return "container"
+	}
+}
+
+// parentIDColumn returns the foreign key column of a child table referencing its parent.
+func parentIDColumn(t *schema.Table) string {
+	for _, c := range t.Columns {
+		if strings.HasSuffix(c.Name, "_cq_id") {
+			return c.Name
+		}
+	}
+	return ""
+}
+
+func hasColumns(t *schema.Table, names ...string) bool {
+	for _, name := range names {
+		found := false
+		for _, c := range t.Columns {
+			if c.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
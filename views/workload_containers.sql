@@ -0,0 +1,78 @@
+-- Code generated by views/gen. DO NOT EDIT.
+DROP VIEW IF EXISTS k8s_workload_containers;
+CREATE VIEW k8s_workload_containers AS
+SELECT w.context, 'DaemonSet' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_daemon_set_containers c
+         JOIN k8s_apps_daemon_sets w ON w.cq_id = c.daemon_set_cq_id
+UNION ALL
+SELECT w.context, 'DaemonSet' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_daemon_set_init_containers c
+         JOIN k8s_apps_daemon_sets w ON w.cq_id = c.daemon_set_cq_id
+UNION ALL
+SELECT w.context, 'Deployment' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_deployment_containers c
+         JOIN k8s_apps_deployments w ON w.cq_id = c.deployment_cq_id
+UNION ALL
+SELECT w.context, 'Deployment' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_deployment_init_containers c
+         JOIN k8s_apps_deployments w ON w.cq_id = c.deployment_cq_id
+UNION ALL
+SELECT w.context, 'ReplicaSet' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_replica_set_containers c
+         JOIN k8s_apps_replica_sets w ON w.cq_id = c.replica_set_cq_id
+UNION ALL
+SELECT w.context, 'ReplicaSet' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_replica_set_init_containers c
+         JOIN k8s_apps_replica_sets w ON w.cq_id = c.replica_set_cq_id
+UNION ALL
+SELECT w.context, 'StatefulSet' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_stateful_set_containers c
+         JOIN k8s_apps_stateful_sets w ON w.cq_id = c.stateful_set_cq_id
+UNION ALL
+SELECT w.context, 'StatefulSet' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_apps_stateful_set_init_containers c
+         JOIN k8s_apps_stateful_sets w ON w.cq_id = c.stateful_set_cq_id
+UNION ALL
+SELECT w.context, 'CronJob' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_batch_cron_job_containers c
+         JOIN k8s_batch_cron_jobs w ON w.cq_id = c.cron_job_cq_id
+UNION ALL
+SELECT w.context, 'CronJob' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_batch_cron_job_init_containers c
+         JOIN k8s_batch_cron_jobs w ON w.cq_id = c.cron_job_cq_id
+UNION ALL
+SELECT w.context, 'Job' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_batch_job_containers c
+         JOIN k8s_batch_jobs w ON w.cq_id = c.job_cq_id
+UNION ALL
+SELECT w.context, 'Job' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_batch_job_init_containers c
+         JOIN k8s_batch_jobs w ON w.cq_id = c.job_cq_id
+UNION ALL
+SELECT w.context, 'Pod' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_core_pod_containers c
+         JOIN k8s_core_pods w ON w.cq_id = c.pod_cq_id
+UNION ALL
+SELECT w.context, 'Pod' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'ephemeral' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_core_pod_ephemeral_containers c
+         JOIN k8s_core_pods w ON w.cq_id = c.pod_cq_id
+UNION ALL
+SELECT w.context, 'Pod' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_core_pod_init_containers c
+         JOIN k8s_core_pods w ON w.cq_id = c.pod_cq_id
+UNION ALL
+SELECT w.context, 'PodTemplate' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_core_pod_template_containers c
+         JOIN k8s_core_pod_templates w ON w.cq_id = c.pod_template_cq_id
+UNION ALL
+SELECT w.context, 'PodTemplate' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_core_pod_template_init_containers c
+         JOIN k8s_core_pod_templates w ON w.cq_id = c.pod_template_cq_id
+UNION ALL
+SELECT w.context, 'ReplicationController' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'container' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_core_replication_controller_containers c
+         JOIN k8s_core_replication_controllers w ON w.cq_id = c.replication_controller_cq_id
+UNION ALL
+SELECT w.context, 'ReplicationController' AS workload_kind, w.uid AS workload_uid, w.namespace, w.name AS workload_name, c.name AS container_name, 'init' AS container_type, c.image, c.privileged, c.allow_privilege_escalation, c.run_as_non_root, c.run_as_user, c.run_as_group, c.read_only_root_filesystem, c.capabilities_add, c.capabilities_drop, c.seccomp_profile_type, c.apparmor_profile
+FROM k8s_core_replication_controller_init_containers c
+         JOIN k8s_core_replication_controllers w ON w.cq_id = c.replication_controller_cq_id;
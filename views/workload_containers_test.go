@@ -0,0 +1,32 @@
+package views
+
+import "testing"
+
+func TestWorkloadKind(t *testing.T) {
+	cases := map[string]string{
+		"k8s_core_pods":                    "Pod",
+		"k8s_apps_stateful_sets":           "StatefulSet",
+		"k8s_batch_cron_jobs":              "CronJob",
+		"k8s_core_replication_controllers": "ReplicationController",
+	}
+	for table, want := range cases {
+		if got := workloadKind(table); got != want {
+			t.Errorf("workloadKind(%q) = %q, expected %q", table, got, want)
+		}
+	}
+}
+
+func TestContainerType(t *testing.T) {
+	cases := map[string]string{
+		"k8s_core_pod_containers":             "container",
+		"k8s_core_pod_init_containers":        "init",
+		"k8s_core_pod_ephemeral_containers":   "ephemeral",
+		"k8s_apps_deployment_init_containers": "init",
+		"k8s_batch_cron_job_containers":       "container",
+	}
+	for table, want := range cases {
+		if got := containerType(table); got != want {
+			t.Errorf("containerType(%q) = %q, expected %q", table, got, want)
+		}
+	}
+}